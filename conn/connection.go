@@ -1,6 +1,8 @@
 package conn
 
 import (
+	"context"
+
 	"github.com/LimePay/go-sdk/consts"
 	"github.com/LimePay/go-sdk/http"
 	"github.com/LimePay/go-sdk/types"
@@ -18,9 +20,14 @@ func NewClient(requester http.Requester) *ConnectionClient {
 
 // Test -
 func (c *ConnectionClient) Test() (*types.Ping, error) {
+	return c.TestContext(context.Background())
+}
+
+// TestContext -
+func (c *ConnectionClient) TestContext(ctx context.Context) (*types.Ping, error) {
 	ping := &types.Ping{}
 
-	err := c.requester.ExecuteRequest(consts.HTTPGet, consts.RoutePing, nil, ping)
+	err := c.requester.ExecuteRequestContext(ctx, consts.HTTPGet, consts.RoutePing, nil, ping)
 
 	return ping, err
 }
@@ -21,4 +21,9 @@ const (
 	RouteGetInvoice           string = "/payments/%s/invoice/preview"
 	RouteGetReceipt           string = "/payments/%s/receipt"
 	RouteGetSignatureMetadata string = "/payments/metadata?shopperId=%s"
+	DefaultUserAgent          string = "limepay-go-sdk"
+	HeaderIdempotencyKey      string = "Idempotency-Key"
+	HeaderRequestID           string = "X-Request-Id"
+	HeaderWebhookSignature    string = "X-Limepay-Signature"
+	HeaderWebhookTimestamp    string = "X-Limepay-Timestamp"
 )
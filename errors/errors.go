@@ -1,5 +1,12 @@
 package errors
 
+import (
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"net/http"
+)
+
 // SDKError -
 type SDKError struct {
 	ErrName    string `json:"errorName"`
@@ -11,6 +18,129 @@ func (e *SDKError) Error() string {
 	return e.ErrMessage
 }
 
+// Is reports whether target is an SDKError with the same name and code
+func (e *SDKError) Is(target error) bool {
+	t, ok := target.(*SDKError)
+
+	return ok && t.ErrName == e.ErrName && t.ErrCode == e.ErrCode
+}
+
+// APIError is returned when LimePay API responds with an error status
+type APIError struct {
+	StatusCode int
+	ErrName    string
+	ErrCode    int
+	Message    string
+	Body       []byte
+	Method     string
+	Route      string
+	RequestID  string
+}
+
+// NewAPIError builds an APIError from the status code and raw body of an error
+// response. Bodies which are not LimePay JSON errors are kept in Body only.
+func NewAPIError(statusCode int, body []byte) *APIError {
+	sdkErr := SDKError{}
+
+	json.Unmarshal(body, &sdkErr)
+
+	apiErr := &APIError{
+		StatusCode: statusCode,
+		ErrName:    sdkErr.ErrName,
+		ErrCode:    sdkErr.ErrCode,
+		Message:    sdkErr.ErrMessage,
+		Body:       body,
+	}
+
+	if apiErr.Message == "" {
+		apiErr.Message = http.StatusText(statusCode)
+	}
+
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	if e.ErrName == "" {
+		return fmt.Sprintf("limepay: %s %s: status %d: %s", e.Method, e.Route, e.StatusCode, e.Message)
+	}
+
+	return fmt.Sprintf("limepay: %s %s: status %d: %s (%s %d)", e.Method, e.Route, e.StatusCode, e.Message, e.ErrName, e.ErrCode)
+}
+
+// Is reports whether target is a predefined SDKError with the same name and
+// code, or an APIError with the same status and code
+func (e *APIError) Is(target error) bool {
+	switch t := target.(type) {
+	case *SDKError:
+		return t.ErrName == e.ErrName && t.ErrCode == e.ErrCode
+	case *APIError:
+		return t.StatusCode == e.StatusCode && t.ErrCode == e.ErrCode
+	}
+
+	return false
+}
+
+// IsNotFound reports whether err is an API error with 404 status or a
+// lookup which did not match anything
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound) || stderrors.Is(err, ShopperNotFoundError)
+}
+
+// IsUnauthorized reports whether err is an API error with 401 status
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized)
+}
+
+// IsRetryable reports whether err is an API error which is worth retrying
+func IsRetryable(err error) bool {
+	apiErr := &APIError{}
+
+	if !stderrors.As(err, &apiErr) {
+		return false
+	}
+
+	return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= 500
+}
+
+// SignerMismatchError is returned when an authorization signature was not
+// produced by the expected signer
+type SignerMismatchError struct {
+	Expected  string
+	Recovered string
+}
+
+func (e *SignerMismatchError) Error() string {
+	return fmt.Sprintf("%s Expected %s, recovered %s", SignatureMismatchError.ErrMessage, e.Expected, e.Recovered)
+}
+
+// Is reports whether target is SignatureMismatchError
+func (e *SignerMismatchError) Is(target error) bool {
+	return SignatureMismatchError.Is(target)
+}
+
+// TransitionError is returned when a payment is observed moving between two
+// statuses its lifecycle does not connect
+type TransitionError struct {
+	PaymentID string
+	From      string
+	To        string
+}
+
+func (e *TransitionError) Error() string {
+	return fmt.Sprintf("%s Payment %s moved from %s to %s", InvalidTransitionError.ErrMessage, e.PaymentID, e.From, e.To)
+}
+
+// Is reports whether target is InvalidTransitionError
+func (e *TransitionError) Is(target error) bool {
+	return InvalidTransitionError.Is(target)
+}
+
+func hasStatus(err error, statusCode int) bool {
+	apiErr := &APIError{}
+
+	return stderrors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
+
 // SigningError -
 var SigningError = &SDKError{"SIGNING_ERROR", 1011, "Could not sign authorization signature. Invalid parameters provided."}
 
@@ -22,3 +152,39 @@ var InvalidWeiAmountProvided = &SDKError{"VALIDATION_ERROR", 1018, "Invalid fund
 
 // NoVendorError -
 var NoVendorError = &SDKError{"NO_VENDOR_ERROR", 1019, "You are required to have vendor in order to perform this operation"}
+
+// ShopperNotFoundError -
+var ShopperNotFoundError = &SDKError{"NOT_FOUND_ERROR", 1020, "No shopper matching the given criteria was found"}
+
+// InvalidSignatureError -
+var InvalidSignatureError = &SDKError{"SIGNATURE_ERROR", 1021, "Authorization signature is malformed and its signer could not be recovered"}
+
+// SignatureMismatchError -
+var SignatureMismatchError = &SDKError{"SIGNATURE_ERROR", 1022, "Authorization signature was not produced by the expected signer."}
+
+// UnsupportedPaymentTypeError -
+var UnsupportedPaymentTypeError = &SDKError{"VALIDATION_ERROR", 1023, "Payment type does not support authorization signatures"}
+
+// InvalidSignatureMetadataError -
+var InvalidSignatureMetadataError = &SDKError{"VALIDATION_ERROR", 1024, "Invalid signature metadata. nonce must be a number, escrowAddress and shopperAddress must be valid addresses"}
+
+// InvalidAmountError -
+var InvalidAmountError = &SDKError{"VALIDATION_ERROR", 1025, "Invalid fundTxData object provided. weiAmount and tokenAmount must be non-negative integers fitting uint256"}
+
+// InvalidPaymentDataError -
+var InvalidPaymentDataError = &SDKError{"VALIDATION_ERROR", 1026, "Invalid payment data provided"}
+
+// InvalidWebhookSignatureError -
+var InvalidWebhookSignatureError = &SDKError{"WEBHOOK_ERROR", 1027, "Webhook signature is missing or does not match the payload"}
+
+// WebhookTimestampError -
+var WebhookTimestampError = &SDKError{"WEBHOOK_ERROR", 1028, "Webhook timestamp is missing or outside of the tolerated window"}
+
+// WaitTimeoutError -
+var WaitTimeoutError = &SDKError{"TIMEOUT_ERROR", 1029, "Timed out waiting for the payment to reach the expected status"}
+
+// InvalidTransitionError -
+var InvalidTransitionError = &SDKError{"LIFECYCLE_ERROR", 1030, "Payment status transition is not possible."}
+
+// InvalidWaitOptionsError -
+var InvalidWaitOptionsError = &SDKError{"VALIDATION_ERROR", 1031, "Invalid wait options provided. The poll interval must be positive"}
@@ -0,0 +1,50 @@
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"testing"
+
+	"github.com/LimePay/go-sdk/test/helper"
+)
+
+func TestNewAPIError(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	apiErr := NewAPIError(400, []byte(`{"errorName":"NO_VENDOR_ERROR","code":1019,"message":"No vendor"}`))
+
+	et.Assert(apiErr.StatusCode == 400, "Status code does not match")
+	et.Assert(apiErr.ErrName == "NO_VENDOR_ERROR", "Error name does not match")
+	et.Assert(apiErr.ErrCode == 1019, "Error code does not match")
+	et.Assert(apiErr.Message == "No vendor", "Error message does not match")
+}
+
+func TestAPIErrorIs(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	err := fmt.Errorf("wrapped: %w", NewAPIError(400, []byte(`{"errorName":"NO_VENDOR_ERROR","code":1019}`)))
+
+	et.Assert(stderrors.Is(err, NoVendorError), "Expected API error to match the predefined error")
+	et.Assert(!stderrors.Is(err, SigningError), "Not expected API error to match another predefined error")
+	et.Assert(stderrors.Is(err, &APIError{StatusCode: 400, ErrCode: 1019}), "Expected API error to match by status and code")
+}
+
+func TestSDKErrorIs(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	copied := *SigningError
+
+	et.Assert(stderrors.Is(&copied, SigningError), "Expected copied error to match the predefined error")
+	et.Assert(!stderrors.Is(&copied, NoVendorError), "Not expected error to match another predefined error")
+}
+
+func TestStatusHelpers(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	et.Assert(IsNotFound(NewAPIError(404, nil)), "Expected not found error")
+	et.Assert(IsUnauthorized(NewAPIError(401, nil)), "Expected unauthorized error")
+	et.Assert(IsRetryable(NewAPIError(503, nil)), "Expected 503 to be retryable")
+	et.Assert(IsRetryable(NewAPIError(429, nil)), "Expected 429 to be retryable")
+	et.Assert(!IsRetryable(NewAPIError(400, nil)), "Not expected 400 to be retryable")
+	et.Assert(!IsNotFound(SigningError), "Not expected SDK error to be API error")
+}
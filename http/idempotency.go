@@ -0,0 +1,45 @@
+package http
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+)
+
+type idempotencyKeyCtx struct{}
+
+// ContextWithIdempotencyKey returns a copy of ctx carrying the idempotency key
+// which is sent with POST requests executed within it. The same key is reused
+// by every retry of the request.
+func ContextWithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyCtx{}, key)
+}
+
+// IdempotencyKey returns the idempotency key carried by ctx, if any
+func IdempotencyKey(ctx context.Context) (string, bool) {
+	key, ok := ctx.Value(idempotencyKeyCtx{}).(string)
+
+	return key, ok && key != ""
+}
+
+// EnsureIdempotencyKey returns ctx unchanged if it already carries an
+// idempotency key, otherwise a copy of it carrying a newly generated one
+func EnsureIdempotencyKey(ctx context.Context) context.Context {
+	if _, ok := IdempotencyKey(ctx); ok {
+		return ctx
+	}
+
+	return ContextWithIdempotencyKey(ctx, NewIdempotencyKey())
+}
+
+// NewIdempotencyKey generates a random (version 4 UUID) idempotency key
+func NewIdempotencyKey() string {
+	b := make([]byte, 16)
+
+	rand.Read(b)
+
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
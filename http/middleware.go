@@ -0,0 +1,92 @@
+package http
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"time"
+)
+
+// Request describes an SDK call passing through the middleware chain
+type Request struct {
+	Method string
+	Route  string
+	// Template is the consts route template the route was built from, e.g. /payments/%s
+	Template string
+	// Data is the payload which is encoded as JSON request body
+	Data interface{}
+	// Model receives the decoded response once the call succeeds
+	Model interface{}
+	// Header holds additional headers sent with the call
+	Header http.Header
+}
+
+// Response describes the raw LimePay API response of an SDK call
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// Handler executes an SDK call. The returned response is nil when the call
+// did not reach LimePay API, e.g. on transport errors.
+type Handler func(ctx context.Context, req *Request) (*Response, error)
+
+// Middleware wraps a Handler, e.g. to inspect or mutate every SDK call
+type Middleware func(next Handler) Handler
+
+// WithMiddleware registers middlewares wrapping every call made by the requester.
+// The first registered middleware is the outermost one.
+func WithMiddleware(middlewares ...Middleware) Option {
+	return func(r *BaseRequester) {
+		r.middlewares = append(r.middlewares, middlewares...)
+	}
+}
+
+func chain(handler Handler, middlewares []Middleware) Handler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
+
+	return handler
+}
+
+// HeaderMiddleware adds the given headers to every SDK call
+func HeaderMiddleware(header http.Header) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req *Request) (*Response, error) {
+			for name, values := range header {
+				for _, value := range values {
+					req.Header.Add(name, value)
+				}
+			}
+
+			return next(ctx, req)
+		}
+	}
+}
+
+// LoggingMiddleware logs method, route, status and latency of every SDK call
+func LoggingMiddleware(logger *log.Logger) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req *Request) (*Response, error) {
+			start := time.Now()
+
+			resp, err := next(ctx, req)
+
+			status := 0
+
+			if resp != nil {
+				status = resp.StatusCode
+			}
+
+			if err != nil {
+				logger.Printf("limepay: %s %s status=%d latency=%s error=%q", req.Method, req.Route, status, time.Since(start), err)
+			} else {
+				logger.Printf("limepay: %s %s status=%d latency=%s", req.Method, req.Route, status, time.Since(start))
+			}
+
+			return resp, err
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/LimePay/go-sdk/consts"
+	"github.com/LimePay/go-sdk/test"
+	"github.com/LimePay/go-sdk/test/helper"
+	"github.com/LimePay/go-sdk/types"
+	gock "gopkg.in/h2non/gock.v1"
+)
+
+func TestMiddlewareChain(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Patch("/shoppers/0").
+		Reply(200).
+		JSON(types.Shopper{ID: "0", FirstName: "Alexander"})
+
+	calls := []string{}
+
+	tracking := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(ctx context.Context, req *Request) (*Response, error) {
+				calls = append(calls, name+" before")
+
+				resp, err := next(ctx, req)
+
+				et.Assert(req.Method == consts.HTTPPatch, "Request method does not match")
+				et.Assert(req.Route == "/shoppers/0", "Request route does not match")
+				et.Assert(req.Data.(types.Shopper).FirstName == "Alexander", "Request data does not match")
+				et.Assert(req.Model.(*types.Shopper).ID == "0", "Decoded response does not match")
+				et.Assert(resp.StatusCode == 200, "Response status does not match")
+
+				calls = append(calls, name+" after")
+
+				return resp, err
+			}
+		}
+	}
+
+	requester := NewRequester(test.Env, test.APIKey, test.APISecret, WithMiddleware(tracking("first"), tracking("second")))
+
+	err := requester.ExecuteRequest(consts.HTTPPatch, "/shoppers/0", types.Shopper{FirstName: "Alexander"}, &types.Shopper{})
+
+	et.Assert(err == nil, "Not expected error returned")
+	et.Assert(strings.Join(calls, ",") == "first before,second before,second after,first after", "Middlewares executed in unexpected order")
+}
+
+func TestHeaderMiddleware(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/shoppers/0").
+		MatchHeader("X-Tenant", "tenant-1").
+		Reply(200).
+		JSON(types.Shopper{})
+
+	header := http.Header{}
+	header.Set("X-Tenant", "tenant-1")
+
+	requester := NewRequester(test.Env, test.APIKey, test.APISecret, WithMiddleware(HeaderMiddleware(header)))
+
+	err := requester.ExecuteRequest(consts.HTTPGet, "/shoppers/0", nil, &types.Shopper{})
+
+	et.Assert(err == nil, "Not expected error returned")
+}
+
+func TestLoggingMiddleware(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/shoppers/0").
+		Reply(401).
+		JSON(authorizaionErrorMock)
+
+	buf := new(bytes.Buffer)
+
+	requester := NewRequester(test.Env, test.APIKey, test.APISecret, WithMiddleware(LoggingMiddleware(log.New(buf, "", 0))))
+
+	err := requester.ExecuteRequest(consts.HTTPGet, "/shoppers/0", nil, &types.Shopper{})
+
+	et.Assert(err != nil, "Expected error to be returned")
+	et.Assert(strings.Contains(buf.String(), "GET /shoppers/0 status=401"), "Log line does not contain method, route and status")
+	et.Assert(strings.Contains(buf.String(), "Unauthorized request"), "Log line does not contain error")
+}
@@ -0,0 +1,33 @@
+package http
+
+import (
+	"net/http"
+	"time"
+)
+
+// Option configures a BaseRequester
+type Option func(*BaseRequester)
+
+// WithHTTPClient makes the requester send its calls through the given client,
+// e.g. one sharing a pooled transport
+func WithHTTPClient(client *http.Client) Option {
+	return func(r *BaseRequester) {
+		if client != nil {
+			r.client = client
+		}
+	}
+}
+
+// WithTimeout bounds the duration of every call made by the requester
+func WithTimeout(timeout time.Duration) Option {
+	return func(r *BaseRequester) {
+		r.timeout = timeout
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every call
+func WithUserAgent(userAgent string) Option {
+	return func(r *BaseRequester) {
+		r.userAgent = userAgent
+	}
+}
@@ -2,86 +2,206 @@ package http
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"reflect"
 	"strings"
+	"time"
 
+	"github.com/LimePay/go-sdk/consts"
 	"github.com/LimePay/go-sdk/errors"
 )
 
 // Requester -
 type Requester interface {
 	ExecuteRequest(method string, route string, data interface{}, model interface{}) error
+	ExecuteRequestContext(ctx context.Context, method string, route string, data interface{}, model interface{}) error
 }
 
 // BaseRequester -
 type BaseRequester struct {
-	env       string
-	apiKey    string
-	apiSecret string
+	env         string
+	apiKey      string
+	apiSecret   string
+	client      *http.Client
+	timeout     time.Duration
+	userAgent   string
+	retryPolicy RetryPolicy
+	middlewares []Middleware
+	retryHooks  []RetryHook
+	handler     Handler
 }
 
 // NewRequester -
-func NewRequester(env string, apiKey string, apiSecret string) *BaseRequester {
-	return &BaseRequester{env, apiKey, apiSecret}
+func NewRequester(env string, apiKey string, apiSecret string, opts ...Option) *BaseRequester {
+	r := &BaseRequester{
+		env:       env,
+		apiKey:    apiKey,
+		apiSecret: apiSecret,
+		client:    &http.Client{},
+		userAgent: consts.DefaultUserAgent,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	r.handler = chain(r.execute, r.middlewares)
+
+	return r
 }
 
 // ExecuteRequest -
 func (r *BaseRequester) ExecuteRequest(method string, route string, data interface{}, model interface{}) error {
-	client := &http.Client{}
+	return r.ExecuteRequestContext(context.Background(), method, route, data, model)
+}
 
-	buf := new(bytes.Buffer)
+// ExecuteRequestContext executes the request bound to the given context,
+// so cancelling ctx aborts the in-flight call
+func (r *BaseRequester) ExecuteRequestContext(ctx context.Context, method string, route string, data interface{}, model interface{}) error {
+	if r.timeout > 0 {
+		var cancel context.CancelFunc
 
-	if data != nil {
-		json.NewEncoder(buf).Encode(&data)
-	}
+		ctx, cancel = context.WithTimeout(ctx, r.timeout)
 
-	req, err := http.NewRequest(method, join(r.env, route), buf)
+		defer cancel()
+	}
 
-	if err != nil {
-		return err
+	req := &Request{
+		Method:   method,
+		Route:    route,
+		Template: RouteTemplate(route),
+		Data:     data,
+		Model:    model,
+		Header:   http.Header{},
 	}
 
-	req.SetBasicAuth(r.apiKey, r.apiSecret)
+	_, err := r.handler(ctx, req)
 
-	resp, err := client.Do(req)
+	return err
+}
 
-	if err != nil {
-		return err
-	}
+// execute is the innermost handler of the middleware chain, which sends the
+// request to LimePay API and decodes the response into the request model
+func (r *BaseRequester) execute(ctx context.Context, req *Request) (*Response, error) {
+	var body []byte
 
-	defer resp.Body.Close()
+	if req.Data != nil {
+		buf := new(bytes.Buffer)
 
-	bytes, err := ioutil.ReadAll(resp.Body)
+		if err := json.NewEncoder(buf).Encode(&req.Data); err != nil {
+			return nil, err
+		}
+
+		body = buf.Bytes()
+	}
+
+	httpResp, bytes, err := r.send(ctx, req, body)
 
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	resp := &Response{
+		StatusCode: httpResp.StatusCode,
+		Header:     httpResp.Header,
+		Body:       bytes,
 	}
 
 	if resp.StatusCode >= 400 {
-		sdkErr := errors.SDKError{}
-		json.Unmarshal(bytes, &sdkErr)
-		return &sdkErr
+		apiErr := errors.NewAPIError(resp.StatusCode, bytes)
+		apiErr.Method = req.Method
+		apiErr.Route = req.Route
+		apiErr.RequestID = resp.Header.Get(consts.HeaderRequestID)
+		return resp, apiErr
 	}
 
-	if model == nil {
-		return nil
+	if req.Model == nil {
+		return resp, nil
 	}
 
-	switch reflect.TypeOf(model).String() {
+	switch reflect.TypeOf(req.Model).String() {
 	case "*string":
-		*(model.(*string)) = string(bytes)
+		*(req.Model.(*string)) = string(bytes)
 	default:
-		err = json.Unmarshal(bytes, model)
+		err = json.Unmarshal(bytes, req.Model)
+
+		if err != nil {
+			return resp, err
+		}
+	}
+
+	return resp, nil
+}
+
+// send executes the call, retrying it according to the requester's retry policy
+func (r *BaseRequester) send(ctx context.Context, request *Request, body []byte) (*http.Response, []byte, error) {
+	attempts := r.retryPolicy.attempts()
+
+	for attempt := 1; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, request.Method, join(r.env, request.Route), bytes.NewReader(body))
 
 		if err != nil {
-			return err
+			return nil, nil, err
+		}
+
+		for name, values := range request.Header {
+			req.Header[name] = values
+		}
+
+		req.SetBasicAuth(r.apiKey, r.apiSecret)
+
+		if r.userAgent != "" {
+			req.Header.Set("User-Agent", r.userAgent)
+		}
+
+		if key, ok := IdempotencyKey(ctx); ok && request.Method == consts.HTTPPost {
+			req.Header.Set(consts.HeaderIdempotencyKey, key)
+		}
+
+		resp, respBody, err := r.do(req)
+
+		retryable := attempt < attempts && isRetryableRequest(req) && ctx.Err() == nil &&
+			(err != nil || isRetryableStatus(resp.StatusCode))
+
+		if !retryable {
+			return resp, respBody, err
+		}
+
+		delay, ok := r.retryPolicy.retryAfter(resp)
+
+		if !ok {
+			delay = r.retryPolicy.backoff(attempt - 1)
+		}
+
+		if err := sleep(ctx, delay); err != nil {
+			return nil, nil, err
 		}
+
+		for _, hook := range r.retryHooks {
+			hook(ctx, request, attempt+1)
+		}
+	}
+}
+
+func (r *BaseRequester) do(req *http.Request) (*http.Response, []byte, error) {
+	resp, err := r.client.Do(req)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	defer resp.Body.Close()
+
+	bytes, err := ioutil.ReadAll(resp.Body)
+
+	if err != nil {
+		return nil, nil, err
 	}
 
-	return nil
+	return resp, bytes, nil
 }
 
 func join(strs ...string) string {
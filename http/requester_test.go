@@ -1,8 +1,12 @@
 package http
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/LimePay/go-sdk/consts"
 	sdkerr "github.com/LimePay/go-sdk/errors"
@@ -36,6 +40,21 @@ func TestNewRequester(t *testing.T) {
 	et.Assert(test.APISecret == requester.apiSecret, "Requester apiSecret does not match")
 }
 
+func TestNewRequesterWithOptions(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	client := &http.Client{}
+
+	requester := NewRequester(test.Env, test.APIKey, test.APISecret,
+		WithHTTPClient(client),
+		WithTimeout(5*time.Second),
+		WithUserAgent("test-agent"))
+
+	et.Assert(client == requester.client, "Requester client does not match")
+	et.Assert(5*time.Second == requester.timeout, "Requester timeout does not match")
+	et.Assert("test-agent" == requester.userAgent, "Requester userAgent does not match")
+}
+
 func TestExecuteRequest(t *testing.T) {
 	et := helper.WrapTesting(t)
 
@@ -70,6 +89,119 @@ func TestExecuteRequestWithData(t *testing.T) {
 	et.Assert(err == nil, "Not expected error returned")
 }
 
+func TestExecuteRequestContext(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/shoppers/0").
+		Reply(200).
+		JSON(types.Shopper{})
+
+	requester := NewRequester(test.Env, test.APIKey, test.APISecret)
+
+	err := requester.ExecuteRequestContext(context.Background(), consts.HTTPGet, "/shoppers/0", nil, &types.Shopper{})
+
+	et.Assert(err == nil, "Not expected error returned")
+}
+
+func TestExecuteRequestContextCanceled(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/shoppers/0").
+		Reply(200).
+		JSON(types.Shopper{})
+
+	requester := NewRequester(test.Env, test.APIKey, test.APISecret)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := requester.ExecuteRequestContext(ctx, consts.HTTPGet, "/shoppers/0", nil, &types.Shopper{})
+
+	et.Assert(err != nil, "Expected error to be returned")
+	et.Assert(errors.Is(err, context.Canceled), "Expected context cancellation error")
+}
+
+func TestExecuteRequestWithUserAgent(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/shoppers/0").
+		MatchHeader("User-Agent", "test-agent").
+		Reply(200).
+		JSON(types.Shopper{})
+
+	requester := NewRequester(test.Env, test.APIKey, test.APISecret, WithUserAgent("test-agent"))
+
+	err := requester.ExecuteRequest(consts.HTTPGet, "/shoppers/0", nil, &types.Shopper{})
+
+	et.Assert(err == nil, "Not expected error returned")
+}
+
+func TestExecuteRequestWithHTTPClient(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	client := &http.Client{Transport: &http.Transport{}}
+
+	gock.InterceptClient(client)
+	defer gock.RestoreClient(client)
+
+	gock.New(test.Env).
+		Get("/shoppers/0").
+		Reply(200).
+		JSON(types.Shopper{})
+
+	requester := NewRequester(test.Env, test.APIKey, test.APISecret, WithHTTPClient(client))
+
+	err := requester.ExecuteRequest(consts.HTTPGet, "/shoppers/0", nil, &types.Shopper{})
+
+	et.Assert(err == nil, "Not expected error returned")
+	et.Assert(gock.IsDone(), "Request was not sent through the provided client")
+}
+
+func TestEnsureIdempotencyKey(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	ctx := EnsureIdempotencyKey(context.Background())
+
+	key, ok := IdempotencyKey(ctx)
+
+	et.Assert(ok && len(key) == 36, "Expected idempotency key to be generated")
+
+	ctx = EnsureIdempotencyKey(ctx)
+
+	sameKey, _ := IdempotencyKey(ctx)
+
+	et.Assert(key == sameKey, "Existing idempotency key should be kept")
+	et.Assert(NewIdempotencyKey() != NewIdempotencyKey(), "Generated idempotency keys should be unique")
+}
+
+func TestExecuteRequestWithEncodingErr(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Post("/payments").
+		Reply(200)
+
+	requester := NewRequester(test.Env, test.APIKey, test.APISecret)
+
+	err := requester.ExecuteRequest(consts.HTTPPost, "/payments", map[string]interface{}{"amount": json.Number("+1")}, nil)
+
+	et.Assert(err != nil, "Expected encoding error to be returned")
+	et.Assert(gock.IsPending(), "Request should not be sent when its body cannot be encoded")
+}
+
 func TestExecuteRequestWithWrongMethod(t *testing.T) {
 	et := helper.WrapTesting(t)
 
@@ -129,11 +261,17 @@ func TestExecuteRequestThrowingAuthorizationErr(t *testing.T) {
 
 	et.Assert(err != nil, "Expected error to be returned")
 
-	sdkError := err.(*sdkerr.SDKError)
+	sdkError := &sdkerr.APIError{}
+
+	et.Assert(errors.As(err, &sdkError), "Expected API error to be returned")
 
 	et.Assert(authorizaionErrorMock.ErrName == sdkError.ErrName, "Error name does not match")
 	et.Assert(authorizaionErrorMock.ErrCode == sdkError.ErrCode, "Error code does not match")
-	et.Assert(authorizaionErrorMock.ErrMessage == sdkError.ErrMessage, "Error message does not match")
+	et.Assert(authorizaionErrorMock.ErrMessage == sdkError.Message, "Error message does not match")
+	et.Assert(sdkError.StatusCode == 401, "Error status code does not match")
+	et.Assert(sdkError.Method == consts.HTTPGet, "Error method does not match")
+	et.Assert(sdkError.Route == "/shoppers/0", "Error route does not match")
+	et.Assert(sdkerr.IsUnauthorized(err), "Expected unauthorized error")
 }
 
 func TestExecuteRequestThrowingInternalServerErr(t *testing.T) {
@@ -152,9 +290,56 @@ func TestExecuteRequestThrowingInternalServerErr(t *testing.T) {
 
 	et.Assert(err != nil, "Expected error to be returned")
 
-	sdkError := err.(*sdkerr.SDKError)
+	sdkError := &sdkerr.APIError{}
+
+	et.Assert(errors.As(err, &sdkError), "Expected API error to be returned")
 
 	et.Assert(internalServerErrorMock.ErrName == sdkError.ErrName, "Error name does not match")
 	et.Assert(internalServerErrorMock.ErrCode == sdkError.ErrCode, "Error code does not match")
-	et.Assert(internalServerErrorMock.ErrMessage == sdkError.ErrMessage, "Error message does not match")
+	et.Assert(internalServerErrorMock.ErrMessage == sdkError.Message, "Error message does not match")
+}
+
+func TestExecuteRequestThrowingNonJSONErr(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/shoppers/0").
+		Reply(502).
+		SetHeader(consts.HeaderRequestID, "req-123").
+		BodyString("<html>Bad Gateway</html>")
+
+	requester := NewRequester(test.Env, test.APIKey, test.APISecret)
+
+	err := requester.ExecuteRequest(consts.HTTPGet, "/shoppers/0", nil, &types.Shopper{})
+
+	sdkError := &sdkerr.APIError{}
+
+	et.Assert(errors.As(err, &sdkError), "Expected API error to be returned")
+	et.Assert(sdkError.StatusCode == 502, "Error status code does not match")
+	et.Assert(sdkError.Message == "Bad Gateway", "Error message should fall back to status text")
+	et.Assert(string(sdkError.Body) == "<html>Bad Gateway</html>", "Error body does not match")
+	et.Assert(sdkError.RequestID == "req-123", "Error request ID does not match")
+	et.Assert(sdkerr.IsRetryable(err), "Expected retryable error")
+}
+
+func TestRouteTemplate(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	routes := map[string]string{
+		"/ping":                            consts.RoutePing,
+		"/payments":                        consts.RouteGetAllPayments,
+		"/payments/relayed":                consts.RouteCreateRelayedPayment,
+		"/payments/123":                    consts.RouteGetPayment,
+		"/payments/123/invoice":            consts.RouteSendInvoice,
+		"/payments/123/invoice/preview":    consts.RouteGetInvoice,
+		"/payments/metadata?shopperId=123": consts.RouteGetSignatureMetadata,
+		"/shoppers/123/walletToken":        consts.RouteGetWalletToken,
+		"/unknown/123?foo=bar":             "/unknown/123",
+	}
+
+	for route, template := range routes {
+		et.Assert(RouteTemplate(route) == template, "Route template does not match for", route)
+	}
 }
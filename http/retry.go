@@ -0,0 +1,155 @@
+package http
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/LimePay/go-sdk/consts"
+)
+
+// RetryPolicy describes how the requester retries failed calls.
+// Transport errors, 429 and 5xx responses are retried for idempotent methods
+// and for POST requests carrying an idempotency key.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between two attempts, including delays
+	// requested by the server through Retry-After
+	MaxBackoff time.Duration
+	// Multiplier grows the delay after every retry
+	Multiplier float64
+	// Jitter is the fraction (0 to 1) of the delay which is randomized
+	Jitter float64
+}
+
+// DefaultRetryPolicy returns a policy making up to 3 attempts with
+// exponential backoff starting at 200ms
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 200 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+	}
+}
+
+// WithRetryPolicy enables retries of failed calls according to the given policy
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(r *BaseRequester) {
+		r.retryPolicy = policy
+	}
+}
+
+// RetryHook is notified right before the given attempt (2 for the first retry)
+// of a request is sent
+type RetryHook func(ctx context.Context, req *Request, attempt int)
+
+// WithRetryHook registers a hook notified about every retried attempt
+func WithRetryHook(hook RetryHook) Option {
+	return func(r *BaseRequester) {
+		r.retryHooks = append(r.retryHooks, hook)
+	}
+}
+
+func (p RetryPolicy) attempts() int {
+	if p.MaxAttempts < 1 {
+		return 1
+	}
+
+	return p.MaxAttempts
+}
+
+func (p RetryPolicy) backoff(retry int) time.Duration {
+	multiplier := p.Multiplier
+
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	delay := float64(p.InitialBackoff) * math.Pow(multiplier, float64(retry))
+
+	if p.MaxBackoff > 0 && delay > float64(p.MaxBackoff) {
+		delay = float64(p.MaxBackoff)
+	}
+
+	if p.Jitter > 0 {
+		jitter := math.Min(p.Jitter, 1)
+		delay -= delay * jitter * rand.Float64()
+	}
+
+	return time.Duration(delay)
+}
+
+func isRetryableRequest(req *http.Request) bool {
+	switch req.Method {
+	case consts.HTTPGet, consts.HTTPPut, consts.HTTPDelete, http.MethodHead, http.MethodOptions:
+		return true
+	case consts.HTTPPost:
+		return req.Header.Get(consts.HeaderIdempotencyKey) != ""
+	}
+
+	return false
+}
+
+func isRetryableStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= 500
+}
+
+// retryAfter parses the Retry-After header, given either in seconds or as HTTP
+// date, capping it to MaxBackoff
+func (p RetryPolicy) retryAfter(resp *http.Response) (time.Duration, bool) {
+	delay, ok := parseRetryAfter(resp)
+
+	if ok && p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+
+	return delay, ok
+}
+
+func parseRetryAfter(resp *http.Response) (time.Duration, bool) {
+	if resp == nil {
+		return 0, false
+	}
+
+	value := resp.Header.Get("Retry-After")
+
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+
+		if delay < 0 {
+			delay = 0
+		}
+
+		return delay, true
+	}
+
+	return 0, false
+}
+
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,262 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/LimePay/go-sdk/consts"
+	sdkerr "github.com/LimePay/go-sdk/errors"
+	"github.com/LimePay/go-sdk/test"
+	"github.com/LimePay/go-sdk/test/helper"
+	"github.com/LimePay/go-sdk/types"
+	gock "gopkg.in/h2non/gock.v1"
+)
+
+var retryPolicyMock = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: time.Millisecond,
+	MaxBackoff:     5 * time.Millisecond,
+	Multiplier:     2,
+	Jitter:         0.5,
+}
+
+func TestExecuteRequestRetriesServerErrors(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/shoppers/0").
+		Reply(502)
+
+	gock.New(test.Env).
+		Get("/shoppers/0").
+		Reply(503).
+		JSON(internalServerErrorMock)
+
+	gock.New(test.Env).
+		Get("/shoppers/0").
+		Reply(200).
+		JSON(types.Shopper{ID: "0"})
+
+	requester := NewRequester(test.Env, test.APIKey, test.APISecret, WithRetryPolicy(retryPolicyMock))
+
+	shopper := &types.Shopper{}
+
+	err := requester.ExecuteRequest(consts.HTTPGet, "/shoppers/0", nil, shopper)
+
+	et.Assert(err == nil, "Not expected error returned")
+	et.Assert(shopper.ID == "0", "Shopper ID does not match")
+	et.Assert(gock.IsDone(), "Expected all attempts to be executed")
+}
+
+func TestExecuteRequestRetriesTooManyRequestsHonoringRetryAfter(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/shoppers/0").
+		Reply(429).
+		SetHeader("Retry-After", "1")
+
+	gock.New(test.Env).
+		Get("/shoppers/0").
+		Reply(200).
+		JSON(types.Shopper{ID: "0"})
+
+	policy := retryPolicyMock
+	policy.MaxBackoff = 2 * time.Second
+
+	requester := NewRequester(test.Env, test.APIKey, test.APISecret, WithRetryPolicy(policy))
+
+	start := time.Now()
+
+	err := requester.ExecuteRequest(consts.HTTPGet, "/shoppers/0", nil, &types.Shopper{})
+
+	et.Assert(err == nil, "Not expected error returned")
+	et.Assert(time.Since(start) >= time.Second, "Expected Retry-After to be honored")
+	et.Assert(gock.IsDone(), "Expected all attempts to be executed")
+}
+
+func TestExecuteRequestCapsRetryAfterToMaxBackoff(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/shoppers/0").
+		Reply(429).
+		SetHeader("Retry-After", "3600")
+
+	gock.New(test.Env).
+		Get("/shoppers/0").
+		Reply(503).
+		SetHeader("Retry-After", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+
+	gock.New(test.Env).
+		Get("/shoppers/0").
+		Reply(200).
+		JSON(types.Shopper{ID: "0"})
+
+	requester := NewRequester(test.Env, test.APIKey, test.APISecret, WithRetryPolicy(retryPolicyMock))
+
+	start := time.Now()
+
+	err := requester.ExecuteRequest(consts.HTTPGet, "/shoppers/0", nil, &types.Shopper{})
+
+	et.Assert(err == nil, "Not expected error returned", err)
+	et.Assert(time.Since(start) < time.Second, "Expected Retry-After to be capped to MaxBackoff", time.Since(start))
+	et.Assert(gock.IsDone(), "Expected all attempts to be executed")
+}
+
+func TestExecuteRequestStopsAfterMaxAttempts(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/shoppers/0").
+		Times(3).
+		Reply(503).
+		JSON(internalServerErrorMock)
+
+	gock.New(test.Env).
+		Get("/shoppers/0").
+		Reply(200).
+		JSON(types.Shopper{})
+
+	requester := NewRequester(test.Env, test.APIKey, test.APISecret, WithRetryPolicy(retryPolicyMock))
+
+	err := requester.ExecuteRequest(consts.HTTPGet, "/shoppers/0", nil, &types.Shopper{})
+
+	et.Assert(err != nil, "Expected error to be returned")
+
+	sdkError := &sdkerr.APIError{}
+
+	et.Assert(errors.As(err, &sdkError), "Expected API error to be returned")
+
+	et.Assert(internalServerErrorMock.ErrCode == sdkError.ErrCode, "Error code does not match")
+	et.Assert(gock.IsPending(), "Expected no more than MaxAttempts attempts")
+}
+
+func TestExecuteRequestRetriesTransportErrors(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/shoppers/0").
+		ReplyError(errors.New("Protocol error"))
+
+	gock.New(test.Env).
+		Get("/shoppers/0").
+		Reply(200).
+		JSON(types.Shopper{})
+
+	requester := NewRequester(test.Env, test.APIKey, test.APISecret, WithRetryPolicy(retryPolicyMock))
+
+	err := requester.ExecuteRequest(consts.HTTPGet, "/shoppers/0", nil, &types.Shopper{})
+
+	et.Assert(err == nil, "Not expected error returned")
+	et.Assert(gock.IsDone(), "Expected all attempts to be executed")
+}
+
+func TestExecuteRequestDoesNotRetryClientErrors(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/shoppers/0").
+		Reply(401).
+		JSON(authorizaionErrorMock)
+
+	gock.New(test.Env).
+		Get("/shoppers/0").
+		Reply(200).
+		JSON(types.Shopper{})
+
+	requester := NewRequester(test.Env, test.APIKey, test.APISecret, WithRetryPolicy(retryPolicyMock))
+
+	err := requester.ExecuteRequest(consts.HTTPGet, "/shoppers/0", nil, &types.Shopper{})
+
+	et.Assert(err != nil, "Expected error to be returned")
+	et.Assert(gock.IsPending(), "Client errors should not be retried")
+}
+
+func TestExecuteRequestDoesNotRetryPostWithoutIdempotencyKey(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Post("/shoppers").
+		Reply(503).
+		JSON(internalServerErrorMock)
+
+	gock.New(test.Env).
+		Post("/shoppers").
+		Reply(200).
+		JSON(types.Shopper{})
+
+	requester := NewRequester(test.Env, test.APIKey, test.APISecret, WithRetryPolicy(retryPolicyMock))
+
+	err := requester.ExecuteRequest(consts.HTTPPost, "/shoppers", &types.Shopper{}, &types.Shopper{})
+
+	et.Assert(err != nil, "Expected error to be returned")
+	et.Assert(gock.IsPending(), "POST without idempotency key should not be retried")
+}
+
+func TestExecuteRequestRetriesPostReusingIdempotencyKey(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Post("/shoppers").
+		MatchHeader(consts.HeaderIdempotencyKey, "key-123").
+		Reply(503).
+		JSON(internalServerErrorMock)
+
+	gock.New(test.Env).
+		Post("/shoppers").
+		MatchHeader(consts.HeaderIdempotencyKey, "key-123").
+		Reply(200).
+		JSON(types.Shopper{})
+
+	requester := NewRequester(test.Env, test.APIKey, test.APISecret, WithRetryPolicy(retryPolicyMock))
+
+	ctx := ContextWithIdempotencyKey(context.Background(), "key-123")
+
+	err := requester.ExecuteRequestContext(ctx, consts.HTTPPost, "/shoppers", &types.Shopper{}, &types.Shopper{})
+
+	et.Assert(err == nil, "Not expected error returned")
+	et.Assert(gock.IsDone(), "Expected POST with idempotency key to be retried")
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	policy := RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     300 * time.Millisecond,
+		Multiplier:     2,
+	}
+
+	et.Assert(policy.backoff(0) == 100*time.Millisecond, "First backoff does not match")
+	et.Assert(policy.backoff(1) == 200*time.Millisecond, "Second backoff does not match")
+	et.Assert(policy.backoff(2) == 300*time.Millisecond, "Backoff should be capped by MaxBackoff")
+
+	policy.Jitter = 0.5
+
+	for i := 0; i < 100; i++ {
+		delay := policy.backoff(1)
+
+		et.Assert(delay >= 100*time.Millisecond && delay <= 200*time.Millisecond, "Jittered backoff out of range")
+	}
+}
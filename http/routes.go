@@ -0,0 +1,73 @@
+package http
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/LimePay/go-sdk/consts"
+)
+
+// routeTemplates lists the API routes, literal ones first, so that e.g.
+// /payments/relayed is not reported as /payments/%s
+var routeTemplates = []string{
+	consts.RoutePing,
+	consts.RouteGetAllVendors,
+	consts.RouteGetAllShoppers,
+	consts.RouteGetAllPayments,
+	consts.RouteCreateRelayedPayment,
+	consts.RouteGetSignatureMetadata,
+	consts.RouteGetWalletToken,
+	consts.RouteSendInvoice,
+	consts.RouteGetInvoice,
+	consts.RouteGetReceipt,
+	consts.RouteGetShopper,
+	consts.RouteGetPayment,
+}
+
+// RouteTemplate returns the consts route template (e.g. /payments/%s) the
+// given route was built from, or the route itself without query when unknown
+func RouteTemplate(route string) string {
+	path := stripQuery(route)
+
+	for _, template := range routeTemplates {
+		if matchesTemplate(path, stripQuery(template)) {
+			return template
+		}
+	}
+
+	return path
+}
+
+func matchesTemplate(path string, template string) bool {
+	pathSegments := strings.Split(path, "/")
+	templateSegments := strings.Split(template, "/")
+
+	if len(pathSegments) != len(templateSegments) {
+		return false
+	}
+
+	for i, segment := range templateSegments {
+		if segment != "%s" && segment != pathSegments[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func stripQuery(route string) string {
+	if i := strings.Index(route, "?"); i >= 0 {
+		return route[:i]
+	}
+
+	return route
+}
+
+// RouteWithQuery appends the encoded query parameters, if any, to the route
+func RouteWithQuery(route string, query url.Values) string {
+	if len(query) == 0 {
+		return route
+	}
+
+	return route + "?" + query.Encode()
+}
@@ -0,0 +1,159 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/LimePay/go-sdk/errors"
+)
+
+const redacted = "[REDACTED]"
+
+// sensitiveKeys lists the (lower cased) payload and header keys whose values are never logged
+var sensitiveKeys = map[string]bool{
+	"authorization":          true,
+	"authorizationsignature": true,
+	"wallettoken":            true,
+	"apisecret":              true,
+	"privatekey":             true,
+	"password":               true,
+}
+
+// LogOptions configures the slog integration of the requester
+type LogOptions struct {
+	// Level is used for successful calls
+	Level slog.Level
+	// ErrorLevel is used for failed calls
+	ErrorLevel slog.Level
+	// LogPayloads adds the redacted request and response bodies to every record
+	LogPayloads bool
+}
+
+// DefaultLogOptions logs successful calls at debug and failed calls at error level
+func DefaultLogOptions() LogOptions {
+	return LogOptions{
+		Level:      slog.LevelDebug,
+		ErrorLevel: slog.LevelError,
+	}
+}
+
+// WithLogger logs every call made by the requester to the given logger.
+// Credentials, private keys, authorization signatures and wallet tokens are
+// redacted from the logged payloads.
+func WithLogger(logger *slog.Logger, opts LogOptions) Option {
+	return func(r *BaseRequester) {
+		r.middlewares = append(r.middlewares, slogMiddleware(logger, opts, r.apiSecret))
+	}
+}
+
+func slogMiddleware(logger *slog.Logger, opts LogOptions, secret string) Middleware {
+	return func(next Handler) Handler {
+		return func(ctx context.Context, req *Request) (*Response, error) {
+			start := time.Now()
+
+			resp, err := next(ctx, req)
+
+			level := opts.Level
+
+			if err != nil {
+				level = opts.ErrorLevel
+			}
+
+			if !logger.Enabled(ctx, level) {
+				return resp, err
+			}
+
+			attrs := []slog.Attr{
+				slog.String("method", req.Method),
+				slog.String("route", req.Route),
+				slog.Duration("latency", time.Since(start)),
+			}
+
+			if resp != nil {
+				attrs = append(attrs, slog.Int("status", resp.StatusCode))
+			}
+
+			if err != nil {
+				apiErr := &errors.APIError{}
+
+				if stderrors.As(err, &apiErr) {
+					attrs = append(attrs, slog.String("errorName", apiErr.ErrName), slog.Int("errorCode", apiErr.ErrCode))
+				}
+
+				attrs = append(attrs, slog.String("error", redactSecret(err.Error(), secret)))
+			}
+
+			if opts.LogPayloads {
+				if len(req.Header) > 0 {
+					attrs = append(attrs, slog.String("headers", redactSecret(redactValue(req.Header), secret)))
+				}
+
+				if req.Data != nil {
+					attrs = append(attrs, slog.String("request", redactSecret(redactValue(req.Data), secret)))
+				}
+
+				if resp != nil && len(resp.Body) > 0 {
+					attrs = append(attrs, slog.String("response", redactSecret(redactBody(resp.Body), secret)))
+				}
+			}
+
+			logger.LogAttrs(ctx, level, "limepay request", attrs...)
+
+			return resp, err
+		}
+	}
+}
+
+func redactValue(value interface{}) string {
+	b, err := json.Marshal(value)
+
+	if err != nil {
+		return fmt.Sprintf("[unserializable %T]", value)
+	}
+
+	return redactBody(b)
+}
+
+func redactBody(body []byte) string {
+	var payload interface{}
+
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return fmt.Sprintf("[non-JSON body, %d bytes]", len(body))
+	}
+
+	b, _ := json.Marshal(redactPayload(payload))
+
+	return string(b)
+}
+
+func redactPayload(payload interface{}) interface{} {
+	switch p := payload.(type) {
+	case map[string]interface{}:
+		for key, value := range p {
+			if sensitiveKeys[strings.ToLower(key)] {
+				p[key] = redacted
+			} else {
+				p[key] = redactPayload(value)
+			}
+		}
+	case []interface{}:
+		for i, value := range p {
+			p[i] = redactPayload(value)
+		}
+	}
+
+	return payload
+}
+
+func redactSecret(s string, secret string) string {
+	if secret == "" {
+		return s
+	}
+
+	return strings.ReplaceAll(s, secret, redacted)
+}
@@ -0,0 +1,112 @@
+package http
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/LimePay/go-sdk/consts"
+	"github.com/LimePay/go-sdk/test"
+	"github.com/LimePay/go-sdk/test/helper"
+	"github.com/LimePay/go-sdk/types"
+	gock "gopkg.in/h2non/gock.v1"
+)
+
+func TestWithLogger(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/shoppers/0/walletToken").
+		Reply(200).
+		JSON(types.WalletToken{WalletToken: "secret-wallet-token"})
+
+	buf := new(bytes.Buffer)
+	logger := slog.New(slog.NewTextHandler(buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	opts := DefaultLogOptions()
+	opts.LogPayloads = true
+
+	requester := NewRequester(test.Env, test.APIKey, test.APISecret, WithLogger(logger, opts))
+
+	err := requester.ExecuteRequest(consts.HTTPGet, "/shoppers/0/walletToken", nil, &types.WalletToken{})
+
+	output := buf.String()
+
+	et.Assert(err == nil, "Not expected error returned")
+	et.Assert(strings.Contains(output, "level=DEBUG"), "Record level does not match")
+	et.Assert(strings.Contains(output, "method=GET route=/shoppers/0/walletToken"), "Record does not contain method and route")
+	et.Assert(strings.Contains(output, "status=200"), "Record does not contain status")
+	et.Assert(strings.Contains(output, "latency="), "Record does not contain latency")
+	et.Assert(!strings.Contains(output, "secret-wallet-token"), "Wallet token should be redacted")
+}
+
+func TestWithLoggerRedactsPayloads(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Post("/payments").
+		Reply(400).
+		JSON(map[string]interface{}{
+			"errorName": "VALIDATION_ERROR",
+			"code":      1017,
+			"message":   "Invalid request for " + test.APISecret,
+		})
+
+	buf := new(bytes.Buffer)
+	logger := slog.New(slog.NewTextHandler(buf, nil))
+
+	opts := DefaultLogOptions()
+	opts.LogPayloads = true
+
+	header := http.Header{}
+	header.Set("Authorization", "Basic dGVzdA==")
+
+	requester := NewRequester(test.Env, test.APIKey, test.APISecret, WithMiddleware(HeaderMiddleware(header)), WithLogger(logger, opts))
+
+	payment := types.Payment{
+		Shopper: "shopper-1",
+		FundTxData: types.FundTxData{
+			WeiAmount:              types.MustParseAmount("60000000000000000"),
+			AuthorizationSignature: "0xsignature",
+		},
+	}
+
+	err := requester.ExecuteRequest(consts.HTTPPost, "/payments", payment, &types.Payment{})
+
+	output := buf.String()
+
+	et.Assert(err != nil, "Expected error to be returned")
+	et.Assert(strings.Contains(output, "level=ERROR"), "Record level does not match")
+	et.Assert(strings.Contains(output, "errorName=VALIDATION_ERROR errorCode=1017"), "Record does not contain error name and code")
+	et.Assert(strings.Contains(output, "shopper-1"), "Record does not contain request payload")
+	et.Assert(!strings.Contains(output, "0xsignature"), "Authorization signature should be redacted")
+	et.Assert(!strings.Contains(output, "dGVzdA=="), "Authorization header should be redacted")
+	et.Assert(!strings.Contains(output, test.APISecret), "API secret should be redacted")
+}
+
+func TestWithLoggerRespectsLevel(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/shoppers/0").
+		Reply(200).
+		JSON(types.Shopper{})
+
+	buf := new(bytes.Buffer)
+	logger := slog.New(slog.NewTextHandler(buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+
+	requester := NewRequester(test.Env, test.APIKey, test.APISecret, WithLogger(logger, DefaultLogOptions()))
+
+	err := requester.ExecuteRequest(consts.HTTPGet, "/shoppers/0", nil, &types.Shopper{})
+
+	et.Assert(err == nil, "Not expected error returned")
+	et.Assert(buf.Len() == 0, "Debug records should not be logged at info level")
+}
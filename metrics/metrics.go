@@ -0,0 +1,131 @@
+package metrics
+
+import (
+	"context"
+	stderrors "errors"
+	"strconv"
+	"time"
+
+	"github.com/LimePay/go-sdk/errors"
+	"github.com/LimePay/go-sdk/http"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const namespace = "limepay"
+
+// Collector is a prometheus.Collector of the SDK traffic. All metrics are
+// labelled with the consts route template (e.g. /payments/%s) of the call.
+type Collector struct {
+	requests *prometheus.CounterVec
+	latency  *prometheus.HistogramVec
+	errors   *prometheus.CounterVec
+	retries  *prometheus.CounterVec
+	inFlight *prometheus.GaugeVec
+}
+
+// NewCollector creates a collector using the default latency buckets
+func NewCollector() *Collector {
+	return NewCollectorWithBuckets(prometheus.DefBuckets)
+}
+
+// NewCollectorWithBuckets creates a collector using the given latency buckets (in seconds)
+func NewCollectorWithBuckets(buckets []float64) *Collector {
+	return &Collector{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "requests_total",
+			Help:      "Number of LimePay API calls by method, route and status.",
+		}, []string{"method", "route", "status"}),
+		latency: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "request_duration_seconds",
+			Help:      "Latency of LimePay API calls, including retries.",
+			Buckets:   buckets,
+		}, []string{"method", "route"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "errors_total",
+			Help:      "Number of failed LimePay API calls by LimePay error name and code.",
+		}, []string{"method", "route", "error_name", "error_code"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "retries_total",
+			Help:      "Number of retried LimePay API call attempts.",
+		}, []string{"method", "route"}),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "requests_in_flight",
+			Help:      "Number of LimePay API calls currently in progress.",
+		}, []string{"method", "route"}),
+	}
+}
+
+// Describe implements prometheus.Collector
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	c.requests.Describe(ch)
+	c.latency.Describe(ch)
+	c.errors.Describe(ch)
+	c.retries.Describe(ch)
+	c.inFlight.Describe(ch)
+}
+
+// Collect implements prometheus.Collector
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.requests.Collect(ch)
+	c.latency.Collect(ch)
+	c.errors.Collect(ch)
+	c.retries.Collect(ch)
+	c.inFlight.Collect(ch)
+}
+
+// Middleware records count, latency, errors and in-flight calls
+func (c *Collector) Middleware() http.Middleware {
+	return func(next http.Handler) http.Handler {
+		return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			inFlight := c.inFlight.WithLabelValues(req.Method, req.Template)
+			inFlight.Inc()
+			defer inFlight.Dec()
+
+			start := time.Now()
+
+			resp, err := next(ctx, req)
+
+			c.latency.WithLabelValues(req.Method, req.Template).Observe(time.Since(start).Seconds())
+
+			status := "error"
+
+			if resp != nil {
+				status = strconv.Itoa(resp.StatusCode)
+			}
+
+			c.requests.WithLabelValues(req.Method, req.Template, status).Inc()
+
+			if err != nil {
+				errName, errCode := "", ""
+
+				apiErr := &errors.APIError{}
+
+				if stderrors.As(err, &apiErr) {
+					errName, errCode = apiErr.ErrName, strconv.Itoa(apiErr.ErrCode)
+				}
+
+				c.errors.WithLabelValues(req.Method, req.Template, errName, errCode).Inc()
+			}
+
+			return resp, err
+		}
+	}
+}
+
+// ObserveRetry is a http.RetryHook counting retried attempts
+func (c *Collector) ObserveRetry(ctx context.Context, req *http.Request, attempt int) {
+	c.retries.WithLabelValues(req.Method, req.Template).Inc()
+}
+
+// Options returns the requester options wiring the collector into every call
+func (c *Collector) Options() []http.Option {
+	return []http.Option{
+		http.WithMiddleware(c.Middleware()),
+		http.WithRetryHook(c.ObserveRetry),
+	}
+}
@@ -0,0 +1,59 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/LimePay/go-sdk/consts"
+	"github.com/LimePay/go-sdk/http"
+	"github.com/LimePay/go-sdk/test"
+	"github.com/LimePay/go-sdk/test/helper"
+	"github.com/LimePay/go-sdk/types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	gock "gopkg.in/h2non/gock.v1"
+)
+
+func TestCollector(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/payments/1").
+		Reply(503)
+
+	gock.New(test.Env).
+		Get("/payments/1").
+		Reply(200).
+		JSON(types.Payment{})
+
+	gock.New(test.Env).
+		Get("/payments/2").
+		Reply(404).
+		JSON(map[string]interface{}{"errorName": "NOT_FOUND_ERROR", "code": 1004})
+
+	collector := NewCollector()
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collector)
+
+	opts := append(collector.Options(), http.WithRetryPolicy(http.RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond}))
+
+	requester := http.NewRequester(test.Env, test.APIKey, test.APISecret, opts...)
+
+	err := requester.ExecuteRequest(consts.HTTPGet, "/payments/1", nil, &types.Payment{})
+
+	et.Assert(err == nil, "Not expected error returned")
+
+	err = requester.ExecuteRequest(consts.HTTPGet, "/payments/2", nil, &types.Payment{})
+
+	et.Assert(err != nil, "Expected error to be returned")
+
+	et.Assert(testutil.ToFloat64(collector.requests.WithLabelValues("GET", consts.RouteGetPayment, "200")) == 1, "Successful requests count does not match")
+	et.Assert(testutil.ToFloat64(collector.requests.WithLabelValues("GET", consts.RouteGetPayment, "404")) == 1, "Failed requests count does not match")
+	et.Assert(testutil.ToFloat64(collector.errors.WithLabelValues("GET", consts.RouteGetPayment, "NOT_FOUND_ERROR", "1004")) == 1, "Errors count does not match")
+	et.Assert(testutil.ToFloat64(collector.retries.WithLabelValues("GET", consts.RouteGetPayment)) == 1, "Retries count does not match")
+	et.Assert(testutil.ToFloat64(collector.inFlight.WithLabelValues("GET", consts.RouteGetPayment)) == 0, "In-flight requests should be back to zero")
+	et.Assert(testutil.CollectAndCount(collector, "limepay_request_duration_seconds") == 1, "Expected one latency histogram")
+}
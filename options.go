@@ -0,0 +1,114 @@
+package limepaysdk
+
+import (
+	"log/slog"
+	nethttp "net/http"
+	"time"
+
+	"github.com/LimePay/go-sdk/http"
+	"github.com/LimePay/go-sdk/metrics"
+	"github.com/LimePay/go-sdk/payments"
+	"github.com/LimePay/go-sdk/tracing"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Option configures the SDK instance created by Connect
+type Option func(*config)
+
+type config struct {
+	baseURL          string
+	ping             bool
+	requesterOptions []http.Option
+	paymentsOptions  []payments.Option
+}
+
+func newConfig(env string, opts []Option) *config {
+	c := &config{baseURL: env, ping: true}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// WithHTTPClient sends all API calls through the given client
+func WithHTTPClient(client *nethttp.Client) Option {
+	return func(c *config) {
+		c.requesterOptions = append(c.requesterOptions, http.WithHTTPClient(client))
+	}
+}
+
+// WithTimeout bounds the duration of every API call
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *config) {
+		c.requesterOptions = append(c.requesterOptions, http.WithTimeout(timeout))
+	}
+}
+
+// WithUserAgent overrides the User-Agent header sent to LimePay
+func WithUserAgent(userAgent string) Option {
+	return func(c *config) {
+		c.requesterOptions = append(c.requesterOptions, http.WithUserAgent(userAgent))
+	}
+}
+
+// WithBaseURL overrides the API base URL derived from the environment
+func WithBaseURL(baseURL string) Option {
+	return func(c *config) {
+		c.baseURL = baseURL
+	}
+}
+
+// WithoutPing skips the /ping connection check performed by Connect
+func WithoutPing() Option {
+	return func(c *config) {
+		c.ping = false
+	}
+}
+
+// WithRetryPolicy retries failed API calls according to the given policy
+func WithRetryPolicy(policy http.RetryPolicy) Option {
+	return func(c *config) {
+		c.requesterOptions = append(c.requesterOptions, http.WithRetryPolicy(policy))
+	}
+}
+
+// WithMiddleware wraps every API call with the given middlewares
+func WithMiddleware(middlewares ...http.Middleware) Option {
+	return func(c *config) {
+		c.requesterOptions = append(c.requesterOptions, http.WithMiddleware(middlewares...))
+	}
+}
+
+// WithLogger logs every API call to the given structured logger
+func WithLogger(logger *slog.Logger, opts http.LogOptions) Option {
+	return func(c *config) {
+		c.requesterOptions = append(c.requesterOptions, http.WithLogger(logger, opts))
+	}
+}
+
+// WithTracing creates an OpenTelemetry span for every API call and for every
+// step of payment creation. A nil provider uses the global one.
+func WithTracing(tp trace.TracerProvider) Option {
+	return func(c *config) {
+		c.requesterOptions = append(c.requesterOptions, http.WithMiddleware(tracing.Middleware(tp)))
+		c.paymentsOptions = append(c.paymentsOptions, payments.WithTracerProvider(tp))
+	}
+}
+
+// WithMetrics records Prometheus metrics of every API call into the given
+// collector, which is to be registered by the caller
+func WithMetrics(collector *metrics.Collector) Option {
+	return func(c *config) {
+		c.requesterOptions = append(c.requesterOptions, collector.Options()...)
+	}
+}
+
+// WithSigningScheme signs payment authorizations of the fiat and relayed
+// payments clients with the given scheme
+func WithSigningScheme(scheme payments.SigningScheme) Option {
+	return func(c *config) {
+		c.paymentsOptions = append(c.paymentsOptions, payments.WithSigningScheme(scheme))
+	}
+}
@@ -0,0 +1,79 @@
+package pagination
+
+import (
+	"context"
+	"iter"
+	"reflect"
+
+	"github.com/LimePay/go-sdk/types"
+)
+
+// DefaultPageSize is used by iterators when no limit is requested
+const DefaultPageSize = 100
+
+// Fetcher fetches the page described by the given options
+type Fetcher[T any] func(ctx context.Context, opts types.ListOptions) (*types.Page[T], error)
+
+// All lazily iterates over every item, fetching the next page only once the
+// previous one has been consumed. Pages are followed by cursor when the API
+// returns one, by offset otherwise. Iteration stops after the first error, and
+// after a page larger than the limit or repeating the previous one, as returned
+// by APIs ignoring the paging options.
+func All[T any](ctx context.Context, opts types.ListOptions, fetch Fetcher[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		if opts.Limit <= 0 {
+			opts.Limit = DefaultPageSize
+		}
+
+		var previous []T
+
+		for {
+			page, err := fetch(ctx, opts)
+
+			if err != nil {
+				var zero T
+
+				yield(zero, err)
+
+				return
+			}
+
+			if previous != nil && reflect.DeepEqual(page.Items, previous) {
+				return
+			}
+
+			for _, item := range page.Items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if !next(&opts, page) {
+				return
+			}
+
+			previous = page.Items
+		}
+	}
+}
+
+// next moves opts to the page following the given one, reporting whether there is one
+func next[T any](opts *types.ListOptions, page *types.Page[T]) bool {
+	if len(page.Items) > opts.Limit || (opts.Cursor != "" && page.NextCursor == opts.Cursor) {
+		return false
+	}
+
+	if page.NextCursor != "" {
+		opts.Cursor = page.NextCursor
+
+		return true
+	}
+
+	if opts.Cursor != "" || len(page.Items) < opts.Limit {
+		return false
+	}
+
+	opts.Offset += len(page.Items)
+
+	return page.Total == 0 || opts.Offset < page.Total
+}
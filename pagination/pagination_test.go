@@ -0,0 +1,177 @@
+package pagination
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/LimePay/go-sdk/test/helper"
+	"github.com/LimePay/go-sdk/types"
+)
+
+func offsetFetcher(total int, calls *[]types.ListOptions) Fetcher[int] {
+	return func(ctx context.Context, opts types.ListOptions) (*types.Page[int], error) {
+		*calls = append(*calls, opts)
+
+		page := &types.Page[int]{}
+
+		for i := opts.Offset; i < total && i < opts.Offset+opts.Limit; i++ {
+			page.Items = append(page.Items, i)
+		}
+
+		return page, nil
+	}
+}
+
+func TestAllByOffset(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	calls := []types.ListOptions{}
+
+	items := []int{}
+
+	for item, err := range All(context.Background(), types.ListOptions{Limit: 2}, offsetFetcher(5, &calls)) {
+		et.Assert(err == nil, "Not expected error returned")
+
+		items = append(items, item)
+	}
+
+	et.Assert(len(items) == 5, "Expected all items to be iterated")
+	et.Assert(len(calls) == 3, "Expected three pages to be fetched")
+	et.Assert(calls[2].Offset == 4, "Last page offset does not match")
+}
+
+func TestAllStopsFetchingWhenConsumerBreaks(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	calls := []types.ListOptions{}
+
+	for item := range All(context.Background(), types.ListOptions{Limit: 2}, offsetFetcher(10, &calls)) {
+		if item == 2 {
+			break
+		}
+	}
+
+	et.Assert(len(calls) == 2, "Expected pages to be fetched lazily")
+}
+
+func TestAllByCursor(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	pages := map[string]*types.Page[int]{
+		"":  {Items: []int{0, 1}, NextCursor: "a"},
+		"a": {Items: []int{2, 3}, NextCursor: "b"},
+		"b": {Items: []int{4}},
+	}
+
+	fetch := func(ctx context.Context, opts types.ListOptions) (*types.Page[int], error) {
+		return pages[opts.Cursor], nil
+	}
+
+	items := []int{}
+
+	for item, err := range All(context.Background(), types.ListOptions{Limit: 2}, fetch) {
+		et.Assert(err == nil, "Not expected error returned")
+
+		items = append(items, item)
+	}
+
+	et.Assert(len(items) == 5, "Expected all items to be iterated")
+}
+
+func TestAllYieldsError(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	fetchErr := errors.New("fetch failed")
+
+	fetch := func(ctx context.Context, opts types.ListOptions) (*types.Page[int], error) {
+		if opts.Offset > 0 {
+			return nil, fetchErr
+		}
+
+		return &types.Page[int]{Items: []int{0, 1}}, nil
+	}
+
+	items, errs := 0, []error{}
+
+	for _, err := range All(context.Background(), types.ListOptions{Limit: 2}, fetch) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		items++
+	}
+
+	et.Assert(items == 2, "Expected items of the first page")
+	et.Assert(len(errs) == 1 && errs[0] == fetchErr, "Expected fetch error to be yielded once")
+}
+
+func TestAllStopsWhenLimitIsIgnored(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	body, _ := json.Marshal(make([]int, 150))
+
+	calls := 0
+
+	fetch := func(ctx context.Context, opts types.ListOptions) (*types.Page[int], error) {
+		calls++
+
+		page := &types.Page[int]{}
+
+		return page, json.Unmarshal(body, page)
+	}
+
+	items := 0
+
+	for _, err := range All(context.Background(), types.ListOptions{}, fetch) {
+		et.Assert(err == nil, "Not expected error returned")
+
+		items++
+	}
+
+	et.Assert(calls == 1, "Expected the bare array to be fetched once", calls)
+	et.Assert(items == 150, "Expected every item of the bare array to be iterated once", items)
+}
+
+func TestAllStopsOnRepeatedPage(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	calls := 0
+
+	fetch := func(ctx context.Context, opts types.ListOptions) (*types.Page[int], error) {
+		calls++
+
+		return &types.Page[int]{Items: []int{0, 1}, NextCursor: "a"}, nil
+	}
+
+	items := 0
+
+	for _, err := range All(context.Background(), types.ListOptions{Limit: 2}, fetch) {
+		et.Assert(err == nil, "Not expected error returned")
+
+		items++
+	}
+
+	et.Assert(calls == 2, "Expected paging to stop once the cursor repeats", calls)
+	et.Assert(items == 2, "Expected no duplicated items", items)
+
+	calls = 0
+	items = 0
+
+	ignoringOffset := func(ctx context.Context, opts types.ListOptions) (*types.Page[int], error) {
+		calls++
+
+		return &types.Page[int]{Items: []int{0, 1}}, nil
+	}
+
+	for _, err := range All(context.Background(), types.ListOptions{Limit: 2}, ignoringOffset) {
+		et.Assert(err == nil, "Not expected error returned")
+
+		items++
+	}
+
+	et.Assert(calls == 2, "Expected paging to stop once a page repeats", calls)
+	et.Assert(items == 2, "Expected no duplicated items", items)
+}
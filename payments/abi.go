@@ -0,0 +1,110 @@
+package payments
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// EncodePacked encodes the values the way Solidity's abi.encodePacked does:
+// uint256 and bytes32 as 32 bytes, address as 20 bytes, bool as a single byte,
+// string and bytes as their raw content. Numbers are given as decimal (or 0x
+// prefixed hex) strings, addresses, bytes32 and bytes as 0x prefixed hex strings.
+func EncodePacked(paramTypes []string, paramValues []string) ([]byte, error) {
+	if len(paramTypes) != len(paramValues) {
+		return nil, fmt.Errorf("abi: %d types provided for %d values", len(paramTypes), len(paramValues))
+	}
+
+	packed := []byte{}
+
+	for i, paramType := range paramTypes {
+		encoded, err := encodePackedValue(paramType, paramValues[i])
+
+		if err != nil {
+			return nil, fmt.Errorf("abi: invalid %s value at position %d: %v", paramType, i, err)
+		}
+
+		packed = append(packed, encoded...)
+	}
+
+	return packed, nil
+}
+
+// SoliditySHA3 returns the Keccak256 hash of the packed values, as computed by
+// keccak256(abi.encodePacked(...)) in Solidity
+func SoliditySHA3(paramTypes []string, paramValues []string) (common.Hash, error) {
+	packed, err := EncodePacked(paramTypes, paramValues)
+
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return crypto.Keccak256Hash(packed), nil
+}
+
+func encodePackedValue(paramType string, value string) ([]byte, error) {
+	switch paramType {
+	case "uint256":
+		return encodeUint256(value)
+	case "address":
+		if !common.IsHexAddress(value) {
+			return nil, errors.New("not a hex address")
+		}
+
+		return common.HexToAddress(value).Bytes(), nil
+	case "bytes32":
+		b, err := hexutil.Decode(value)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(b) > 32 {
+			return nil, errors.New("longer than 32 bytes")
+		}
+
+		return common.RightPadBytes(b, 32), nil
+	case "bool":
+		switch value {
+		case "true":
+			return []byte{1}, nil
+		case "false":
+			return []byte{0}, nil
+		}
+
+		return nil, errors.New("not a boolean")
+	case "string":
+		return []byte(value), nil
+	case "bytes":
+		return hexutil.Decode(value)
+	}
+
+	return nil, errors.New("unsupported type")
+}
+
+func encodeUint256(value string) ([]byte, error) {
+	n, ok := new(big.Int), false
+
+	if strings.HasPrefix(value, "0x") || strings.HasPrefix(value, "0X") {
+		n, ok = n.SetString(value[2:], 16)
+	} else {
+		n, ok = n.SetString(value, 10)
+	}
+
+	if !ok {
+		return nil, errors.New("not a number")
+	}
+
+	if n.Sign() < 0 || n.Cmp(maxUint256) > 0 {
+		return nil, errors.New("out of uint256 range")
+	}
+
+	return common.LeftPadBytes(n.Bytes(), 32), nil
+}
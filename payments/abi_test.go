@@ -0,0 +1,101 @@
+package payments
+
+import (
+	"testing"
+
+	"github.com/LimePay/go-sdk/test/helper"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestSoliditySHA3(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	// Expected hashes as computed by web3.utils.soliditySha3
+	tests := []struct {
+		name     string
+		types    []string
+		values   []string
+		expected string
+	}{
+		{"uint256", []string{"uint256"}, []string{"234"}, "0x61c831beab28d67d1bb40b5ae1a11e2757fa842f031a2d0bc94a7867bc5d26c2"},
+		{"uint256 hex", []string{"uint256"}, []string{"0xea"}, "0x61c831beab28d67d1bb40b5ae1a11e2757fa842f031a2d0bc94a7867bc5d26c2"},
+		{"string", []string{"string"}, []string{"Hello!%"}, "0x661136a4267dba9ccdf6bfddb7c00e714de936674c4bdb065a531cf1cb15c7fc"},
+		{"address", []string{"address"}, []string{"0x407D73d8a49eeb85D32Cf465507dd71d507100c1"}, "0x4e8ebbefa452077428f93c9520d3edd60594ff452a29ac7d2ccc11d47f3ab95b"},
+		{"bytes", []string{"bytes"}, []string{"0x407D73d8a49eeb85D32Cf465507dd71d507100c1"}, "0x4e8ebbefa452077428f93c9520d3edd60594ff452a29ac7d2ccc11d47f3ab95b"},
+		{"bytes32", []string{"bytes32"}, []string{"0x407D73d8a49eeb85D32Cf465507dd71d507100c1"}, "0x3c69a194aaf415ba5d6afca734660d0a3d45acdc05d54cd1ca89a8988e7625b4"},
+		{"bool", []string{"bool"}, []string{"true"}, "0x5fe7f977e71dba2ea1a68e21057beebb9be2ac30c6410aa38d4f3fbe41dcffd2"},
+	}
+
+	for _, tt := range tests {
+		hash, err := SoliditySHA3(tt.types, tt.values)
+
+		et.Assert(err == nil, tt.name, "not expected error returned:", err)
+		et.Assert(hash.Hex() == tt.expected, tt.name, "hash does not match:", hash.Hex())
+	}
+}
+
+func TestEncodePacked(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	packed, err := EncodePacked(
+		[]string{"uint256", "address", "bool", "string"},
+		[]string{"1", "0x37688cFc875DC6AA6D39fE8449A759e434a86482", "false", "ab"})
+
+	et.Assert(err == nil, "Not expected error returned")
+	et.Assert(len(packed) == 32+20+1+2, "Packed length does not match")
+	et.Assert(packed[31] == 1, "uint256 should be left padded to 32 bytes")
+	et.Assert(packed[32] == 0x37 && packed[51] == 0x82, "address should take 20 bytes")
+	et.Assert(packed[52] == 0, "bool should take a single byte")
+	et.Assert(string(packed[53:]) == "ab", "string should be encoded as raw bytes")
+}
+
+func TestEncodePackedInvalidValues(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	tests := []struct {
+		name   string
+		types  []string
+		values []string
+	}{
+		{"types and values mismatch", []string{"uint256", "address"}, []string{"1"}},
+		{"negative uint256", []string{"uint256"}, []string{"-1"}},
+		{"uint256 overflow", []string{"uint256"}, []string{"115792089237316195423570985008687907853269984665640564039457584007913129639936"}},
+		{"scientific uint256", []string{"uint256"}, []string{"1e18"}},
+		{"short address", []string{"address"}, []string{"0x123"}},
+		{"long bytes32", []string{"bytes32"}, []string{"0x" + "00000000000000000000000000000000000000000000000000000000000000000000"}},
+		{"invalid bool", []string{"bool"}, []string{"yes"}},
+		{"unsupported type", []string{"int8"}, []string{"1"}},
+	}
+
+	for _, tt := range tests {
+		_, err := EncodePacked(tt.types, tt.values)
+
+		et.Assert(err != nil, tt.name, "expected error to be returned")
+	}
+}
+
+func TestSign(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	privateKey := "d723d3cdf932464de15845c0719ca13ce15e64c83625d86ddbfc217bd2ac5f5a"
+
+	paramTypes := []string{"uint256", "address", "address", "uint256", "uint256"}
+	paramValues := []string{"0", "0x37688cFc875DC6AA6D39fE8449A759e434a86482", "0x8E8FD30C784BBb9B80877052AAE4bd9D43BCc034", "10000000000000000000", "60000000000000000"}
+
+	signature, err := (&BasePaymentsClient{}).Sign(privateKey, paramTypes, paramValues)
+
+	et.Assert(err == nil, "Not expected error returned")
+
+	hash, _ := SoliditySHA3(paramTypes, paramValues)
+
+	pk, _ := crypto.HexToECDSA(privateKey)
+	pub, err := crypto.SigToPub(hash.Bytes(), hexutil.MustDecode(signature))
+
+	et.Assert(err == nil, "Signature could not be recovered")
+	et.Assert(crypto.PubkeyToAddress(*pub) == crypto.PubkeyToAddress(pk.PublicKey), "Recovered signer does not match")
+
+	_, err = (&BasePaymentsClient{}).Sign(privateKey, paramTypes, paramValues[1:])
+
+	et.Assert(err != nil, "Expected error for misaligned values")
+}
@@ -0,0 +1,67 @@
+package payments
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/LimePay/go-sdk/errors"
+	"github.com/LimePay/go-sdk/types"
+)
+
+// Authorization is the payload signed to authorize a payment. Names, Types
+// and Values are aligned and listed in the order they are hashed.
+type Authorization struct {
+	PrimaryType   string
+	Names         []string
+	Types         []string
+	Values        []string
+	EscrowAddress string
+}
+
+// NewAuthorization builds the authorization payload of a payment of the given type
+func NewAuthorization(paymentType types.PaymentType, signatureMetadata types.SignatureMetadata, fundTxData types.FundTxData) (*Authorization, error) {
+	switch paymentType {
+	case types.PaymentTypeFiat:
+		if !fundTxData.TokenAmount.IsSet() {
+			fundTxData.TokenAmount = types.NewAmount(big.NewInt(0))
+		}
+
+		if !fundTxData.WeiAmount.IsSet() {
+			return nil, errors.InvalidTokenAndWeiAmountProvided
+		}
+
+		if fundTxData.Validate() != nil {
+			return nil, errors.InvalidAmountError
+		}
+
+		return &Authorization{
+			PrimaryType:   "FiatPayment",
+			Names:         []string{"nonce", "escrowAddress", "shopperAddress", "tokenAmount", "weiAmount"},
+			Types:         []string{"uint256", "address", "address", "uint256", "uint256"},
+			Values:        []string{signatureMetadata.Nonce, signatureMetadata.EscrowAddress, signatureMetadata.ShopperAddress, fundTxData.TokenAmount.String(), fundTxData.WeiAmount.String()},
+			EscrowAddress: signatureMetadata.EscrowAddress,
+		}, nil
+	case types.PaymentTypeRelayed:
+		auth, err := NewRelayedAuthorization(signatureMetadata, fundTxData)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return auth.Authorization(), nil
+	default:
+		return nil, errors.UnsupportedPaymentTypeError
+	}
+}
+
+// TypeString returns the EIP-712 type of the authorization, e.g.
+// RelayedPayment(uint256 nonce,address escrowAddress,address shopperAddress,uint256 weiAmount)
+func (a *Authorization) TypeString() string {
+	members := make([]string, len(a.Names))
+
+	for i, name := range a.Names {
+		members[i] = a.Types[i] + " " + name
+	}
+
+	return a.PrimaryType + "(" + strings.Join(members, ",") + ")"
+}
@@ -0,0 +1,187 @@
+package payments
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/LimePay/go-sdk/errors"
+	"github.com/LimePay/go-sdk/types"
+)
+
+// PaymentBuilder assembles a payment and computes its totals. Line amounts are
+// unit prices, so the base amount is the sum of line amount times quantity of
+// every item. Tax is computed once over the base amount and rounded to the
+// minor units of the currency.
+type PaymentBuilder struct {
+	payment  types.Payment
+	taxRate  *big.Rat
+	rounding types.RoundingMode
+	errs     []string
+}
+
+// NewPaymentBuilder starts a payment in the given ISO 4217 currency
+func NewPaymentBuilder(currency string) *PaymentBuilder {
+	return &PaymentBuilder{
+		payment:  types.Payment{Currency: currency, Items: []types.Item{}},
+		taxRate:  new(big.Rat),
+		rounding: types.RoundHalfUp,
+	}
+}
+
+// Shopper sets the shopper the payment is created for
+func (b *PaymentBuilder) Shopper(shopperID string) *PaymentBuilder {
+	b.payment.Shopper = shopperID
+	return b
+}
+
+// Vendor sets the vendor of the payment
+func (b *PaymentBuilder) Vendor(vendorID string) *PaymentBuilder {
+	b.payment.Vendor = vendorID
+	return b
+}
+
+// AddItem adds a line of quantity units of the given unit price
+func (b *PaymentBuilder) AddItem(description string, unitPrice types.Money, quantity int) *PaymentBuilder {
+	b.payment.Items = append(b.payment.Items, types.Item{
+		Description: description,
+		LineAmount:  unitPrice,
+		Quantity:    quantity,
+	})
+
+	return b
+}
+
+// TaxRate sets the tax rate as a decimal fraction, e.g. "0.2" for 20%
+func (b *PaymentBuilder) TaxRate(rate string) *PaymentBuilder {
+	r, ok := new(big.Rat).SetString(rate)
+
+	if !ok || r.Sign() < 0 || strings.ContainsAny(rate, "/eE") {
+		b.errs = append(b.errs, fmt.Sprintf("tax rate %q is not a non-negative decimal", rate))
+		return b
+	}
+
+	decimals := 0
+
+	if i := strings.IndexByte(rate, '.'); i >= 0 {
+		decimals = len(rate) - i - 1
+	}
+
+	// the rate is sent in its canonical form, e.g. ".5" as "0.5", as JSON
+	// numbers cannot have a sign, leading zeros or a bare decimal point
+	normalized := r.FloatString(decimals)
+
+	if n, _ := new(big.Rat).SetString(normalized); n == nil || n.Cmp(r) != 0 {
+		b.errs = append(b.errs, fmt.Sprintf("tax rate %q is not a non-negative decimal", rate))
+		return b
+	}
+
+	b.taxRate = r
+	b.payment.PaymentDetails.TaxRate = json.Number(normalized)
+
+	return b
+}
+
+// Rounding sets how the tax amount is rounded, RoundHalfUp by default
+func (b *PaymentBuilder) Rounding(mode types.RoundingMode) *PaymentBuilder {
+	b.rounding = mode
+	return b
+}
+
+// CardHolder sets the card holder details of the payment
+func (b *PaymentBuilder) CardHolder(cardHolder types.CardHolder) *PaymentBuilder {
+	b.payment.PaymentDetails.CardHolder = cardHolder
+	return b
+}
+
+// FundTxData sets the fund transaction of the payment
+func (b *PaymentBuilder) FundTxData(fundTxData types.FundTxData) *PaymentBuilder {
+	b.payment.FundTxData = fundTxData
+	return b
+}
+
+// GenericTransactions adds transactions to be relayed along the payment
+func (b *PaymentBuilder) GenericTransactions(transactions ...types.GenericTransaction) *PaymentBuilder {
+	b.payment.GenericTransactions = append(b.payment.GenericTransactions, transactions...)
+	return b
+}
+
+// Build validates the payment and computes its base, tax and total amounts.
+// Errors wrap errors.InvalidPaymentDataError.
+func (b *PaymentBuilder) Build() (types.Payment, error) {
+	errs := append([]string{}, b.errs...)
+
+	payment := b.payment
+	payment.Items = append([]types.Item{}, b.payment.Items...)
+
+	if len(payment.Currency) != 3 {
+		errs = append(errs, fmt.Sprintf("currency %q is not an ISO 4217 code", payment.Currency))
+	}
+
+	if payment.Shopper == "" {
+		errs = append(errs, "shopper is required")
+	}
+
+	if len(payment.Items) == 0 {
+		errs = append(errs, "at least one item is required")
+	}
+
+	base := types.NewMoney(0, payment.Currency)
+
+	for i, item := range payment.Items {
+		if !strings.EqualFold(item.LineAmount.Currency, payment.Currency) {
+			errs = append(errs, fmt.Sprintf("item %d is in %s instead of %s", i, item.LineAmount.Currency, payment.Currency))
+			continue
+		}
+
+		if item.LineAmount.Minor < 0 {
+			errs = append(errs, fmt.Sprintf("item %d has a negative amount", i))
+		}
+
+		if item.Quantity <= 0 {
+			errs = append(errs, fmt.Sprintf("item %d has a non-positive quantity", i))
+		}
+
+		payment.Items[i].LineAmount.Currency = payment.Currency
+
+		line, err := payment.Items[i].LineAmount.Mul(int64(item.Quantity))
+
+		if err == nil {
+			line, err = base.Add(line)
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("item %d: %s", i, err))
+			continue
+		}
+
+		base = line
+	}
+
+	if len(errs) > 0 {
+		return types.Payment{}, fmt.Errorf("%w: %s", errors.InvalidPaymentDataError, strings.Join(errs, "; "))
+	}
+
+	tax, err := base.MulRate(b.taxRate, b.rounding)
+
+	if err != nil {
+		return types.Payment{}, fmt.Errorf("%w: tax: %s", errors.InvalidPaymentDataError, err)
+	}
+
+	total, err := base.Add(tax)
+
+	if err != nil {
+		return types.Payment{}, fmt.Errorf("%w: total: %s", errors.InvalidPaymentDataError, err)
+	}
+
+	payment.PaymentDetails.BaseAmount = base
+	payment.PaymentDetails.TaxAmount = tax
+	payment.PaymentDetails.TotalAmount = total
+
+	if payment.PaymentDetails.TaxRate == "" {
+		payment.PaymentDetails.TaxRate = "0"
+	}
+
+	return payment, nil
+}
@@ -0,0 +1,132 @@
+package payments
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/LimePay/go-sdk/errors"
+	"github.com/LimePay/go-sdk/test/helper"
+	"github.com/LimePay/go-sdk/types"
+)
+
+func TestPaymentBuilder(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	tests := []struct {
+		name     string
+		builder  *PaymentBuilder
+		base     string
+		tax      string
+		total    string
+		currency string
+	}{
+		{
+			"USD with tax",
+			NewPaymentBuilder("USD").
+				AddItem("Book", types.MustParseMoney("19.99", "USD"), 3).
+				AddItem("Shipping", types.MustParseMoney("5", "USD"), 1).
+				TaxRate("0.2"),
+			"64.97", "12.99", "77.96", "USD",
+		},
+		{
+			"without tax",
+			NewPaymentBuilder("EUR").AddItem("Service", types.MustParseMoney("16777217.01", "EUR"), 1),
+			"16777217.01", "0.00", "16777217.01", "EUR",
+		},
+		{
+			"half up",
+			NewPaymentBuilder("USD").AddItem("Pen", types.MustParseMoney("1.25", "USD"), 1).TaxRate("0.1"),
+			"1.25", "0.13", "1.38", "USD",
+		},
+		{
+			"half even",
+			NewPaymentBuilder("USD").AddItem("Pen", types.MustParseMoney("1.25", "USD"), 1).TaxRate("0.1").Rounding(types.RoundHalfEven),
+			"1.25", "0.12", "1.37", "USD",
+		},
+		{
+			"round down",
+			NewPaymentBuilder("USD").AddItem("Pen", types.MustParseMoney("1.29", "USD"), 1).TaxRate("0.1").Rounding(types.RoundDown),
+			"1.29", "0.12", "1.41", "USD",
+		},
+		{
+			"zero decimal currency",
+			NewPaymentBuilder("JPY").AddItem("Tea", types.MustParseMoney("1234", "JPY"), 1).TaxRate("0.1"),
+			"1234", "123", "1357", "JPY",
+		},
+		{
+			"three decimal currency",
+			NewPaymentBuilder("KWD").AddItem("Fuel", types.MustParseMoney("1.005", "KWD"), 2).TaxRate("0.05"),
+			"2.010", "0.101", "2.111", "KWD",
+		},
+	}
+
+	for _, tt := range tests {
+		payment, err := tt.builder.Shopper("shopper123").Build()
+
+		et.Assert(err == nil, tt.name, "not expected error returned", err)
+		et.Assert(payment.Currency == tt.currency, tt.name, "currency does not match")
+		et.Assert(payment.PaymentDetails.BaseAmount.Decimal() == tt.base, tt.name, "base amount does not match", payment.PaymentDetails.BaseAmount)
+		et.Assert(payment.PaymentDetails.TaxAmount.Decimal() == tt.tax, tt.name, "tax amount does not match", payment.PaymentDetails.TaxAmount)
+		et.Assert(payment.PaymentDetails.TotalAmount.Decimal() == tt.total, tt.name, "total amount does not match", payment.PaymentDetails.TotalAmount)
+	}
+}
+
+func TestPaymentBuilderNormalizesTaxRate(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	tests := []struct {
+		rate     string
+		expected json.Number
+	}{
+		{"0.2", "0.2"},
+		{".5", "0.5"},
+		{"+0.2", "0.2"},
+		{"00.2", "0.2"},
+		{"0.20", "0.20"},
+		{"1.", "1"},
+	}
+
+	for _, tt := range tests {
+		payment, err := NewPaymentBuilder("USD").Shopper("s1").AddItem("Book", types.MustParseMoney("1", "USD"), 1).TaxRate(tt.rate).Build()
+
+		et.Assert(err == nil, tt.rate, "not expected error returned", err)
+		et.Assert(payment.PaymentDetails.TaxRate == tt.expected, tt.rate, "tax rate does not match", payment.PaymentDetails.TaxRate)
+
+		_, err = json.Marshal(payment)
+
+		et.Assert(err == nil, tt.rate, "payment should be marshalled", err)
+	}
+}
+
+func TestPaymentBuilderValidation(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	tests := []struct {
+		name    string
+		builder *PaymentBuilder
+		reason  string
+	}{
+		{"no shopper", NewPaymentBuilder("USD").AddItem("Book", types.MustParseMoney("1", "USD"), 1), "shopper is required"},
+		{"no items", NewPaymentBuilder("USD").Shopper("s1"), "at least one item is required"},
+		{"invalid currency", NewPaymentBuilder("").Shopper("s1").AddItem("Book", types.MustParseMoney("1", ""), 1), "not an ISO 4217 code"},
+		{"currency mismatch", NewPaymentBuilder("USD").Shopper("s1").AddItem("Book", types.MustParseMoney("1", "EUR"), 1), "item 0 is in EUR instead of USD"},
+		{"negative amount", NewPaymentBuilder("USD").Shopper("s1").AddItem("Refund", types.MustParseMoney("-1", "USD"), 1), "item 0 has a negative amount"},
+		{"zero quantity", NewPaymentBuilder("USD").Shopper("s1").AddItem("Book", types.MustParseMoney("1", "USD"), 0), "item 0 has a non-positive quantity"},
+		{"invalid tax rate", NewPaymentBuilder("USD").Shopper("s1").AddItem("Book", types.MustParseMoney("1", "USD"), 1).TaxRate("20%"), "tax rate"},
+		{"negative tax rate", NewPaymentBuilder("USD").Shopper("s1").AddItem("Book", types.MustParseMoney("1", "USD"), 1).TaxRate("-0.2"), "tax rate"},
+		{"line overflow", NewPaymentBuilder("USD").Shopper("s1").AddItem("Yacht", types.NewMoney(math.MaxInt64/2, "USD"), 3), "item 0: money: amount out of range"},
+		{"base overflow", NewPaymentBuilder("USD").Shopper("s1").AddItem("Yacht", types.NewMoney(math.MaxInt64/2, "USD"), 1).AddItem("Yacht", types.NewMoney(math.MaxInt64/2, "USD"), 2), "item 1: money: amount out of range"},
+		{"tax overflow", NewPaymentBuilder("USD").Shopper("s1").AddItem("Yacht", types.NewMoney(math.MaxInt64/2, "USD"), 1).TaxRate("3"), "tax: money: amount out of range"},
+		{"total overflow", NewPaymentBuilder("USD").Shopper("s1").AddItem("Yacht", types.NewMoney(math.MaxInt64/2+1, "USD"), 1).TaxRate("1"), "total: money: amount out of range"},
+	}
+
+	for _, tt := range tests {
+		_, err := tt.builder.Build()
+
+		et.Assert(stderrors.Is(err, errors.InvalidPaymentDataError), tt.name, "expected invalid payment data error", err)
+		et.Assert(err != nil && strings.Contains(err.Error(), tt.reason), tt.name, "error reason does not match", err)
+	}
+}
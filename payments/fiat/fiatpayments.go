@@ -1,24 +1,33 @@
 package fiat
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/LimePay/go-sdk/consts"
 	"github.com/LimePay/go-sdk/errors"
 	"github.com/LimePay/go-sdk/http"
 	"github.com/LimePay/go-sdk/payments"
+	"github.com/LimePay/go-sdk/signer"
+	"github.com/LimePay/go-sdk/tracing"
 	"github.com/LimePay/go-sdk/types"
+	"go.opentelemetry.io/otel/trace"
 )
 
-var fiatSignatureValueTypes = []string{"uint256", "address", "address", "uint256", "uint256"}
-
 type internalPaymentsClient interface {
 	payments.PaymentsClient
 
 	CreatePayment(route string, paymentData types.Payment) (*types.Payment, error)
+	CreatePaymentContext(ctx context.Context, route string, paymentData types.Payment) (*types.Payment, error)
 	GetSignatureMetadata(shopperID string) (types.SignatureMetadata, error)
+	GetSignatureMetadataContext(ctx context.Context, shopperID string) (types.SignatureMetadata, error)
 	Sign(privateKey string, paramTypes []string, paramValues []string) (string, error)
+	SignWith(s signer.Signer, paramTypes []string, paramValues []string) (string, error)
+	SignWithContext(ctx context.Context, s signer.Signer, paramTypes []string, paramValues []string) (string, error)
+	SignAuthorization(s signer.Signer, auth *payments.Authorization) (string, error)
+	SignAuthorizationContext(ctx context.Context, s signer.Signer, auth *payments.Authorization) (string, error)
 	ExecuteRequest(method string, route string, data interface{}, model interface{}) error
+	ExecuteRequestContext(ctx context.Context, method string, route string, data interface{}, model interface{}) error
 }
 
 // FiatPaymentsClient -
@@ -28,56 +37,112 @@ type FiatPaymentsClient interface {
 	GetInvoice(paymentID string) (string, error)
 	SendInvoice(paymentID string) error
 	GetReceipt(paymentID string) (string, error)
+
+	GetInvoiceContext(ctx context.Context, paymentID string) (string, error)
+	SendInvoiceContext(ctx context.Context, paymentID string) error
+	GetReceiptContext(ctx context.Context, paymentID string) (string, error)
 }
 
 // BaseFiatPaymentsClient -
 type BaseFiatPaymentsClient struct {
 	internalPaymentsClient
+	tracerProvider trace.TracerProvider
 }
 
 // NewClient -
-func NewClient(requester http.Requester) *BaseFiatPaymentsClient {
-	return &BaseFiatPaymentsClient{payments.NewClient(requester)}
+func NewClient(requester http.Requester, opts ...payments.Option) *BaseFiatPaymentsClient {
+	client := payments.NewClient(requester, opts...)
+
+	return &BaseFiatPaymentsClient{client, client.TracerProvider}
 }
 
 // Create -
 func (f *BaseFiatPaymentsClient) Create(paymentData types.Payment, privateKey string) (*types.Payment, error) {
-	payment := &types.Payment{}
+	return f.CreateContext(context.Background(), paymentData, privateKey)
+}
+
+// CreateContext -
+func (f *BaseFiatPaymentsClient) CreateContext(ctx context.Context, paymentData types.Payment, privateKey string) (*types.Payment, error) {
+	var s signer.Signer
 
 	if paymentData.FundTxData.AuthorizationSignature == "" {
-		signatureMetadata, err := f.GetSignatureMetadata(paymentData.Shopper)
+		keySigner, err := signer.NewHexKeySigner(privateKey)
 
 		if err != nil {
-			return payment, err
+			return &types.Payment{}, errors.SigningError
 		}
 
-		authorizationSignature, err := f.computeAuthorizationSignature(signatureMetadata, paymentData.FundTxData, privateKey)
+		s = keySigner
+	}
 
-		if err != nil {
-			return payment, err
-		}
+	return f.CreateWithSignerContext(ctx, paymentData, s)
+}
+
+// CreateWithSigner creates a payment, authorizing it with the given signer
+func (f *BaseFiatPaymentsClient) CreateWithSigner(paymentData types.Payment, s signer.Signer) (*types.Payment, error) {
+	return f.CreateWithSignerContext(context.Background(), paymentData, s)
+}
 
-		paymentData.FundTxData.Nonce = signatureMetadata.Nonce
-		paymentData.FundTxData.AuthorizationSignature = authorizationSignature
+// CreateWithSignerContext creates a payment within the given context, authorizing it with the given signer
+func (f *BaseFiatPaymentsClient) CreateWithSignerContext(ctx context.Context, paymentData types.Payment, s signer.Signer) (*types.Payment, error) {
+	if paymentData.FundTxData.Validate() != nil {
+		return &types.Payment{}, errors.InvalidAmountError
 	}
 
-	return f.CreatePayment(consts.RouteCreateFiatPayment, paymentData)
+	payment := &types.Payment{}
+
+	err := tracing.Trace(ctx, f.tracerProvider, "limepay.fiat.Create", func(ctx context.Context) error {
+		if paymentData.FundTxData.AuthorizationSignature == "" {
+			signatureMetadata := types.SignatureMetadata{}
+
+			err := tracing.Trace(ctx, f.tracerProvider, "limepay.fiat.GetSignatureMetadata", func(ctx context.Context) (err error) {
+				signatureMetadata, err = f.GetSignatureMetadataContext(ctx, paymentData.Shopper)
+				return err
+			})
+
+			if err != nil {
+				return err
+			}
+
+			authorizationSignature := ""
+
+			err = tracing.Trace(ctx, f.tracerProvider, "limepay.fiat.Sign", func(ctx context.Context) (err error) {
+				authorizationSignature, err = f.computeAuthorizationSignature(ctx, signatureMetadata, paymentData.FundTxData, s)
+				return err
+			})
+
+			if err != nil {
+				return err
+			}
+
+			paymentData.FundTxData.Nonce = signatureMetadata.Nonce
+			paymentData.FundTxData.AuthorizationSignature = authorizationSignature
+		}
+
+		return tracing.Trace(ctx, f.tracerProvider, "limepay.fiat.CreatePayment", func(ctx context.Context) (err error) {
+			payment, err = f.CreatePaymentContext(ctx, consts.RouteCreateFiatPayment, paymentData)
+			return err
+		})
+	})
+
+	return payment, err
 }
 
-func (f *BaseFiatPaymentsClient) computeAuthorizationSignature(signatureMetadata types.SignatureMetadata, fundTxData types.FundTxData, privateKey string) (string, error) {
-	if fundTxData.TokenAmount == "" {
-		fundTxData.TokenAmount = "0"
+func (f *BaseFiatPaymentsClient) computeAuthorizationSignature(ctx context.Context, signatureMetadata types.SignatureMetadata, fundTxData types.FundTxData, s signer.Signer) (string, error) {
+	auth, err := payments.NewAuthorization(types.PaymentTypeFiat, signatureMetadata, fundTxData)
+
+	if err != nil {
+		return "", err
 	}
 
-	if fundTxData.WeiAmount == "" {
-		return "", errors.InvalidTokenAndWeiAmountProvided
+	if s == nil {
+		return "", errors.SigningError
 	}
 
-	res, err := f.Sign(privateKey, fiatSignatureValueTypes,
-		[]string{signatureMetadata.Nonce, signatureMetadata.EscrowAddress, signatureMetadata.ShopperAddress, fundTxData.TokenAmount, fundTxData.WeiAmount})
+	res, err := f.SignAuthorizationContext(ctx, s, auth)
 
 	if err != nil {
-		return "", errors.SigningError
+		return "", fmt.Errorf("%w: %w", errors.SigningError, err)
 	}
 
 	return res, nil
@@ -85,31 +150,46 @@ func (f *BaseFiatPaymentsClient) computeAuthorizationSignature(signatureMetadata
 
 // GetInvoice -
 func (f *BaseFiatPaymentsClient) GetInvoice(paymentID string) (string, error) {
+	return f.GetInvoiceContext(context.Background(), paymentID)
+}
+
+// GetInvoiceContext -
+func (f *BaseFiatPaymentsClient) GetInvoiceContext(ctx context.Context, paymentID string) (string, error) {
 	route := fmt.Sprintf(consts.RouteGetInvoice, paymentID)
 
 	invoice := ""
 
-	err := f.ExecuteRequest(consts.HTTPGet, route, nil, &invoice)
+	err := f.ExecuteRequestContext(ctx, consts.HTTPGet, route, nil, &invoice)
 
 	return invoice, err
 }
 
 // SendInvoice -
 func (f *BaseFiatPaymentsClient) SendInvoice(paymentID string) error {
+	return f.SendInvoiceContext(context.Background(), paymentID)
+}
+
+// SendInvoiceContext -
+func (f *BaseFiatPaymentsClient) SendInvoiceContext(ctx context.Context, paymentID string) error {
 	route := fmt.Sprintf(consts.RouteSendInvoice, paymentID)
 
-	err := f.ExecuteRequest(consts.HTTPGet, route, nil, nil)
+	err := f.ExecuteRequestContext(ctx, consts.HTTPGet, route, nil, nil)
 
 	return err
 }
 
 // GetReceipt -
 func (f *BaseFiatPaymentsClient) GetReceipt(paymentID string) (string, error) {
+	return f.GetReceiptContext(context.Background(), paymentID)
+}
+
+// GetReceiptContext -
+func (f *BaseFiatPaymentsClient) GetReceiptContext(ctx context.Context, paymentID string) (string, error) {
 	route := fmt.Sprintf(consts.RouteGetReceipt, paymentID)
 
 	receipt := ""
 
-	err := f.ExecuteRequest(consts.HTTPGet, route, nil, &receipt)
+	err := f.ExecuteRequestContext(ctx, consts.HTTPGet, route, nil, &receipt)
 
 	return receipt, err
 }
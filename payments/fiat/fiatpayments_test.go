@@ -1,20 +1,27 @@
 package fiat
 
 import (
+	"context"
+	"math/big"
 	"testing"
 
 	"github.com/LimePay/go-sdk/errors"
 	"github.com/LimePay/go-sdk/http"
+	"github.com/LimePay/go-sdk/payments"
 	"github.com/LimePay/go-sdk/test"
 	"github.com/LimePay/go-sdk/test/helper"
+	"github.com/LimePay/go-sdk/tracing"
 	"github.com/LimePay/go-sdk/types"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	gock "gopkg.in/h2non/gock.v1"
 )
 
 var shopperMetadataMock = types.SignatureMetadata{
 	Nonce:          "0",
-	ShopperAddress: "0x123",
-	EscrowAddress:  "0x321",
+	ShopperAddress: "0x8E8FD30C784BBb9B80877052AAE4bd9D43BCc034",
+	EscrowAddress:  "0x37688cFc875DC6AA6D39fE8449A759e434a86482",
 }
 
 var fiatPaymentMock = types.Payment{
@@ -27,13 +34,13 @@ var fiatPaymentMock = types.Payment{
 	Items: []types.Item{
 		{
 			Description: "Some description",
-			LineAmount:  100.4,
+			LineAmount:  types.MustParseMoney("100.4", "USD"),
 			Quantity:    1,
 		},
 	},
 	FundTxData: types.FundTxData{
-		TokenAmount: "10000000000000000000",
-		WeiAmount:   "60000000000000000",
+		TokenAmount: types.MustParseAmount("10000000000000000000"),
+		WeiAmount:   types.MustParseAmount("60000000000000000"),
 	},
 	GenericTransactions: []types.GenericTransaction{
 		{
@@ -89,12 +96,83 @@ func TestCreate(t *testing.T) {
 	et.Assert(fiatPaymentMock.Currency == res.Currency, "Fiat payment currency does not match")
 	et.Assert(fiatPaymentMock.Shopper == res.Shopper, "Fiat payment shopper does not match")
 	et.Assert(fiatPaymentMock.Vendor == res.Vendor, "Fiat payment vendor does not match")
-	et.Assert(fiatPaymentMock.FundTxData.TokenAmount == res.FundTxData.TokenAmount, "Fiat payment tokenAmount does not match")
-	et.Assert(fiatPaymentMock.FundTxData.WeiAmount == res.FundTxData.WeiAmount, "Fiat payment weiAmount does not match")
+	et.Assert(fiatPaymentMock.FundTxData.TokenAmount.Equal(res.FundTxData.TokenAmount), "Fiat payment tokenAmount does not match")
+	et.Assert(fiatPaymentMock.FundTxData.WeiAmount.Equal(res.FundTxData.WeiAmount), "Fiat payment weiAmount does not match")
 	et.Assert(fiatPaymentMock.Type == res.Type, "Fiat payment type does not match")
 	et.Assert(err == nil, "Not expected error expected")
 }
 
+func TestCreateContextTracing(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/payments/metadata").
+		MatchParam("shopperId", "0").
+		Reply(200).
+		JSON(shopperMetadataMock)
+
+	gock.New(test.Env).
+		Post("/payments").
+		Reply(200).
+		JSON(fiatPaymentMock)
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	requester := http.NewRequester(test.Env, test.APIKey, test.APISecret, http.WithMiddleware(tracing.Middleware(tp)))
+
+	_, err := NewClient(requester, payments.WithTracerProvider(tp)).CreateContext(context.Background(), fiatPaymentMock, privateKeyMock)
+
+	names := map[string]sdktrace.ReadOnlySpan{}
+
+	for _, span := range recorder.Ended() {
+		names[span.Name()] = span
+	}
+
+	et.Assert(err == nil, "Not expected error returned")
+	et.Assert(len(names) == 6, "Expected create, step and request spans", len(names))
+	et.Assert(names["limepay.fiat.Create"] != nil && !names["limepay.fiat.Create"].Parent().IsValid(), "Create span should be a root span")
+
+	createID := names["limepay.fiat.Create"].SpanContext().SpanID()
+
+	for _, step := range []string{"limepay.fiat.GetSignatureMetadata", "limepay.fiat.Sign", "limepay.fiat.CreatePayment"} {
+		et.Assert(names[step] != nil && names[step].Parent().SpanID() == createID, "Step span is not a child of create span:", step)
+	}
+
+	et.Assert(names["GET /payments/metadata?shopperId=%s"].Parent().SpanID() == names["limepay.fiat.GetSignatureMetadata"].SpanContext().SpanID(), "Metadata request span is not a child of its step")
+	et.Assert(names["POST /payments"].Parent().SpanID() == names["limepay.fiat.CreatePayment"].SpanContext().SpanID(), "Create request span is not a child of its step")
+}
+
+func TestCreateContextWithoutTracing(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/payments/metadata").
+		MatchParam("shopperId", "0").
+		Reply(200).
+		JSON(shopperMetadataMock)
+
+	gock.New(test.Env).
+		Post("/payments").
+		Reply(200).
+		JSON(fiatPaymentMock)
+
+	recorder := tracetest.NewSpanRecorder()
+
+	global := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(global)
+
+	_, err := NewClient(http.NewRequester(test.Env, test.APIKey, test.APISecret)).CreateContext(context.Background(), fiatPaymentMock, privateKeyMock)
+
+	et.Assert(err == nil, "Not expected error returned", err)
+	et.Assert(len(recorder.Ended()) == 0, "No span should be created unless tracing is enabled", len(recorder.Ended()))
+}
+
 func TestCreateWithoutWeiAmount(t *testing.T) {
 	et := helper.WrapTesting(t)
 
@@ -194,3 +272,18 @@ func TestGetReceipt(t *testing.T) {
 	et.Assert(receiptMock == res, "Receipt content does not match")
 	et.Assert(err == nil, "Not expected error expected")
 }
+
+func TestCreateWithNegativeAmount(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	paymentData := fiatPaymentMock
+	paymentData.FundTxData.TokenAmount = types.NewAmount(big.NewInt(-1))
+
+	fiatPaymentsClient := NewClient(http.NewRequester(test.Env, test.APIKey, test.APISecret))
+
+	_, err := fiatPaymentsClient.Create(paymentData, privateKeyMock)
+
+	et.Assert(err == errors.InvalidAmountError, "Expected invalid amount error")
+}
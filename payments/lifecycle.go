@@ -0,0 +1,166 @@
+package payments
+
+import (
+	"context"
+	"sync"
+
+	"github.com/LimePay/go-sdk/errors"
+	"github.com/LimePay/go-sdk/types"
+)
+
+// lifecycle lists the statuses a payment can move to directly from each status.
+// Terminal statuses have no successors.
+var lifecycle = map[types.PaymentStatus][]types.PaymentStatus{
+	types.PaymentStatusNew:        {types.PaymentStatusProcessing, types.PaymentStatusFailed, types.PaymentStatusCanceled},
+	types.PaymentStatusProcessing: {types.PaymentStatusSuccessful, types.PaymentStatusFailed},
+}
+
+// CanTransition reports whether a payment can move from one status to the
+// other directly
+func CanTransition(from, to types.PaymentStatus) bool {
+	for _, next := range lifecycle[from] {
+		if next == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Reachable reports whether a payment can move from one status to the other,
+// possibly through intermediate statuses
+func Reachable(from, to types.PaymentStatus) bool {
+	for _, next := range lifecycle[from] {
+		if next == to || Reachable(next, to) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Transition is the change between two observations of a payment. Previous is
+// nil, and From empty, when the payment is observed for the first time.
+type Transition struct {
+	PaymentID string
+	From      types.PaymentStatus
+	To        types.PaymentStatus
+	Previous  *types.Payment
+	Current   *types.Payment
+}
+
+// NewTransition describes the change from previous to current, previous being
+// nil when unknown
+func NewTransition(previous, current *types.Payment) *Transition {
+	t := &Transition{
+		PaymentID: current.ID,
+		To:        current.Status,
+		Previous:  previous,
+		Current:   current,
+	}
+
+	if previous != nil {
+		t.From = previous.Status
+	}
+
+	return t
+}
+
+// Changed reports whether the status of the payment changed
+func (t *Transition) Changed() bool {
+	return t.From != t.To
+}
+
+// Skipped reports whether the payment went through statuses which were not
+// observed, e.g. NEW to SUCCESSFUL when PROCESSING was missed between two polls
+func (t *Transition) Skipped() bool {
+	return !CanTransition(t.From, t.To) && Reachable(t.From, t.To)
+}
+
+// Validate returns an error matching errors.InvalidTransitionError when the
+// lifecycle does not lead from From to To, e.g. SUCCESSFUL to PROCESSING or
+// FAILED to SUCCESSFUL. First observations and statuses unknown to the SDK
+// are not validated.
+func (t *Transition) Validate() error {
+	if t.From == "" || !t.Changed() || t.From.IsUnknown() || t.To.IsUnknown() {
+		return nil
+	}
+
+	if !Reachable(t.From, t.To) {
+		return &errors.TransitionError{PaymentID: t.PaymentID, From: t.From.String(), To: t.To.String()}
+	}
+
+	return nil
+}
+
+// Tracker remembers the last observed state of payments and emits their status
+// changes to the registered callbacks. Payments may be observed from polling,
+// see WithTracker, as well as from webhooks. It is safe for concurrent use;
+// callbacks are to be registered before observing and run while the tracker is
+// locked, so they must not call it.
+type Tracker struct {
+	mu           sync.Mutex
+	payments     map[string]*types.Payment
+	onTransition []func(ctx context.Context, transition *Transition) error
+}
+
+// NewTracker -
+func NewTracker() *Tracker {
+	return &Tracker{
+		payments: map[string]*types.Payment{},
+	}
+}
+
+// OnTransition registers a callback receiving every status change, including
+// the first observation of a payment
+func (t *Tracker) OnTransition(fn func(ctx context.Context, transition *Transition) error) {
+	t.onTransition = append(t.onTransition, fn)
+}
+
+// Observe records the current state of a payment, returning its transition from
+// the last observed state, or nil when its status did not change. An invalid
+// transition, e.g. a stale webhook delivered out of order, is returned along
+// with its error and is not recorded. The state is recorded only once all the
+// callbacks succeed, so the change is emitted again on the next observation
+// otherwise.
+func (t *Tracker) Observe(ctx context.Context, payment *types.Payment) (*Transition, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	transition := NewTransition(t.payments[payment.ID], payment)
+
+	if err := transition.Validate(); err != nil {
+		return transition, err
+	}
+
+	if !transition.Changed() {
+		t.payments[payment.ID] = payment
+		return nil, nil
+	}
+
+	for _, fn := range t.onTransition {
+		if err := fn(ctx, transition); err != nil {
+			return transition, err
+		}
+	}
+
+	t.payments[payment.ID] = payment
+
+	return transition, nil
+}
+
+// Last returns the last observed state of a payment, nil if it was never observed
+func (t *Tracker) Last(paymentID string) *types.Payment {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.payments[paymentID]
+}
+
+// Forget drops the state of a payment, e.g. once it reached a terminal status
+func (t *Tracker) Forget(paymentID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.payments, paymentID)
+}
@@ -0,0 +1,128 @@
+package payments
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+
+	"github.com/LimePay/go-sdk/errors"
+	"github.com/LimePay/go-sdk/test/helper"
+	"github.com/LimePay/go-sdk/types"
+)
+
+func paymentWithStatus(status types.PaymentStatus) *types.Payment {
+	return &types.Payment{ID: "0", Status: status}
+}
+
+func TestTransitionValidate(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	tests := []struct {
+		from    types.PaymentStatus
+		to      types.PaymentStatus
+		valid   bool
+		skipped bool
+	}{
+		{"", "SUCCESSFUL", true, false},
+		{"NEW", "NEW", true, false},
+		{"NEW", "PROCESSING", true, false},
+		{"NEW", "CANCELED", true, false},
+		{"NEW", "SUCCESSFUL", true, true},
+		{"PROCESSING", "SUCCESSFUL", true, false},
+		{"PROCESSING", "FAILED", true, false},
+		{"PROCESSING", "NEW", false, false},
+		{"PROCESSING", "CANCELED", false, false},
+		{"SUCCESSFUL", "PROCESSING", false, false},
+		{"FAILED", "SUCCESSFUL", false, false},
+		{"CANCELED", "NEW", false, false},
+		{"SUCCESSFUL", "REFUNDED", true, false},
+		{"REFUNDED", "NEW", true, false},
+	}
+
+	for _, tt := range tests {
+		var previous *types.Payment
+
+		if tt.from != "" {
+			previous = paymentWithStatus(tt.from)
+		}
+
+		transition := NewTransition(previous, paymentWithStatus(tt.to))
+		err := transition.Validate()
+
+		et.Assert((err == nil) == tt.valid, tt.from, "->", tt.to, "validation does not match", err)
+		et.Assert(transition.Skipped() == tt.skipped, tt.from, "->", tt.to, "skipped does not match")
+
+		if !tt.valid {
+			mismatch := &errors.TransitionError{}
+
+			et.Assert(stderrors.Is(err, errors.InvalidTransitionError), "Error should match InvalidTransitionError", err)
+			et.Assert(stderrors.As(err, &mismatch) && mismatch.From == tt.from.String() && mismatch.To == tt.to.String(),
+				"Error should report the transition", err)
+		}
+	}
+}
+
+func TestTrackerObserve(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	tracker := NewTracker()
+	emitted := []*Transition{}
+
+	tracker.OnTransition(func(ctx context.Context, transition *Transition) error {
+		emitted = append(emitted, transition)
+		return nil
+	})
+
+	ctx := context.Background()
+
+	for _, status := range []types.PaymentStatus{"NEW", "NEW", "PROCESSING", "SUCCESSFUL"} {
+		_, err := tracker.Observe(ctx, paymentWithStatus(status))
+
+		et.Assert(err == nil, "Not expected error returned", status, err)
+	}
+
+	et.Assert(len(emitted) == 3, "Every status change should be emitted once", len(emitted))
+	et.Assert(emitted[0].From == "" && emitted[0].To == "NEW", "First observation does not match")
+	et.Assert(emitted[2].From == "PROCESSING" && emitted[2].To == "SUCCESSFUL", "Last transition does not match")
+	et.Assert(emitted[2].Previous.Status == "PROCESSING", "Previous payment does not match")
+
+	transition, err := tracker.Observe(ctx, paymentWithStatus("PROCESSING"))
+
+	et.Assert(stderrors.Is(err, errors.InvalidTransitionError), "Expected invalid transition error", err)
+	et.Assert(transition != nil && transition.From == "SUCCESSFUL", "Invalid transition should be returned")
+	et.Assert(len(emitted) == 3, "Invalid transition should not be emitted")
+	et.Assert(tracker.Last("0").Status == "SUCCESSFUL", "Invalid transition should not be recorded")
+
+	tracker.Forget("0")
+
+	et.Assert(tracker.Last("0") == nil, "Forgotten payment should not be tracked")
+}
+
+func TestTrackerRetriesFailedCallbacks(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	tracker := NewTracker()
+	calls := 0
+
+	tracker.OnTransition(func(ctx context.Context, transition *Transition) error {
+		calls++
+
+		if calls == 1 {
+			return stderrors.New("callback failed")
+		}
+
+		return nil
+	})
+
+	ctx := context.Background()
+
+	_, err := tracker.Observe(ctx, paymentWithStatus("NEW"))
+
+	et.Assert(err != nil, "Expected callback error to be returned")
+	et.Assert(tracker.Last("0") == nil, "State should not be recorded when a callback fails")
+
+	transition, err := tracker.Observe(ctx, paymentWithStatus("NEW"))
+
+	et.Assert(err == nil, "Not expected error returned", err)
+	et.Assert(transition != nil && transition.To == "NEW", "Change should be emitted again after a failed callback")
+}
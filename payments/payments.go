@@ -1,20 +1,33 @@
 package payments
 
 import (
+	"context"
 	"fmt"
-	"strings"
+	"iter"
 
 	"github.com/LimePay/go-sdk/consts"
 	"github.com/LimePay/go-sdk/http"
+	"github.com/LimePay/go-sdk/pagination"
+	"github.com/LimePay/go-sdk/signer"
 	"github.com/LimePay/go-sdk/types"
 	"github.com/ethereum/go-ethereum/common/hexutil"
-	"github.com/ethereum/go-ethereum/crypto"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
 )
 
 // PaymentsClient -
 type PaymentsClient interface {
 	Get(paymentID string) (*types.Payment, error)
 	GetAll() (*[]types.Payment, error)
+
+	GetContext(ctx context.Context, paymentID string) (*types.Payment, error)
+	GetAllContext(ctx context.Context) (*[]types.Payment, error)
+
+	List(opts types.PaymentListOptions) (*types.Page[types.Payment], error)
+	ListContext(ctx context.Context, opts types.PaymentListOptions) (*types.Page[types.Payment], error)
+	All(ctx context.Context, opts types.PaymentListOptions) iter.Seq2[types.Payment, error]
+
+	WaitFor(ctx context.Context, paymentID string, predicate Predicate, opts ...WaitOption) (*types.Payment, error)
 }
 
 // RichPaymentsClient -
@@ -22,89 +35,210 @@ type RichPaymentsClient interface {
 	PaymentsClient
 
 	Create(paymentData types.Payment, privateKey string) (*types.Payment, error)
+	CreateContext(ctx context.Context, paymentData types.Payment, privateKey string) (*types.Payment, error)
+	CreateWithSigner(paymentData types.Payment, s signer.Signer) (*types.Payment, error)
+	CreateWithSignerContext(ctx context.Context, paymentData types.Payment, s signer.Signer) (*types.Payment, error)
 }
 
 // BasePaymentsClient -
 type BasePaymentsClient struct {
 	Requester http.Requester
+	Scheme    SigningScheme
+	// TracerProvider creates the spans of multi-step operations, a no-op one
+	// unless set by WithTracerProvider
+	TracerProvider trace.TracerProvider
+}
+
+// Option configures a payments client
+type Option func(*BasePaymentsClient)
+
+// WithSigningScheme signs payment authorizations with the given scheme instead of the raw one
+func WithSigningScheme(scheme SigningScheme) Option {
+	return func(p *BasePaymentsClient) {
+		p.Scheme = scheme
+	}
+}
+
+// WithTracerProvider enables the spans of multi-step operations, such as payment
+// creation, created with the given provider. A nil provider uses the one of the
+// active span or the global one. Without it, no span is created.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(p *BasePaymentsClient) {
+		p.TracerProvider = tp
+	}
 }
 
 // NewClient -
-func NewClient(requester http.Requester) *BasePaymentsClient {
-	return &BasePaymentsClient{requester}
+func NewClient(requester http.Requester, opts ...Option) *BasePaymentsClient {
+	p := &BasePaymentsClient{Requester: requester, Scheme: RawScheme{}, TracerProvider: noop.NewTracerProvider()}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	return p
 }
 
 // Get -
 func (p *BasePaymentsClient) Get(paymentID string) (*types.Payment, error) {
+	return p.GetContext(context.Background(), paymentID)
+}
+
+// GetContext -
+func (p *BasePaymentsClient) GetContext(ctx context.Context, paymentID string) (*types.Payment, error) {
 	route := fmt.Sprintf(consts.RouteGetPayment, paymentID)
 
 	payment := &types.Payment{}
 
-	err := p.ExecuteRequest(consts.HTTPGet, route, nil, payment)
+	err := p.ExecuteRequestContext(ctx, consts.HTTPGet, route, nil, payment)
 
 	return payment, err
 }
 
 // GetAll -
 func (p *BasePaymentsClient) GetAll() (*[]types.Payment, error) {
+	return p.GetAllContext(context.Background())
+}
+
+// GetAllContext -
+func (p *BasePaymentsClient) GetAllContext(ctx context.Context) (*[]types.Payment, error) {
 	payments := &[]types.Payment{}
 
-	err := p.ExecuteRequest(consts.HTTPGet, consts.RouteGetAllPayments, nil, payments)
+	err := p.ExecuteRequestContext(ctx, consts.HTTPGet, consts.RouteGetAllPayments, nil, payments)
 
 	return payments, err
 }
 
+// List retrieves a single page of payments matching the given filters
+func (p *BasePaymentsClient) List(opts types.PaymentListOptions) (*types.Page[types.Payment], error) {
+	return p.ListContext(context.Background(), opts)
+}
+
+// ListContext retrieves a single page of payments matching the given filters within the given context
+func (p *BasePaymentsClient) ListContext(ctx context.Context, opts types.PaymentListOptions) (*types.Page[types.Payment], error) {
+	route := http.RouteWithQuery(consts.RouteGetAllPayments, opts.Query())
+
+	page := &types.Page[types.Payment]{}
+
+	err := p.ExecuteRequestContext(ctx, consts.HTTPGet, route, nil, page)
+
+	return page, err
+}
+
+// All lazily iterates over all payments matching the given filters, fetching them page by page
+func (p *BasePaymentsClient) All(ctx context.Context, opts types.PaymentListOptions) iter.Seq2[types.Payment, error] {
+	return pagination.All(ctx, opts.ListOptions, func(ctx context.Context, page types.ListOptions) (*types.Page[types.Payment], error) {
+		opts.ListOptions = page
+
+		return p.ListContext(ctx, opts)
+	})
+}
+
 // CreatePayment -
 func (p *BasePaymentsClient) CreatePayment(route string, paymentData types.Payment) (*types.Payment, error) {
+	return p.CreatePaymentContext(context.Background(), route, paymentData)
+}
+
+// CreatePaymentContext creates a payment within the given context.
+// An idempotency key is generated unless ctx already carries one.
+func (p *BasePaymentsClient) CreatePaymentContext(ctx context.Context, route string, paymentData types.Payment) (*types.Payment, error) {
 	payment := &types.Payment{}
 
-	err := p.ExecuteRequest(consts.HTTPPost, route, paymentData, payment)
+	ctx = http.EnsureIdempotencyKey(ctx)
+
+	err := p.ExecuteRequestContext(ctx, consts.HTTPPost, route, paymentData, payment)
 
 	return payment, err
 }
 
 // GetSignatureMetadata -
 func (p *BasePaymentsClient) GetSignatureMetadata(shopperID string) (types.SignatureMetadata, error) {
+	return p.GetSignatureMetadataContext(context.Background(), shopperID)
+}
+
+// GetSignatureMetadataContext -
+func (p *BasePaymentsClient) GetSignatureMetadataContext(ctx context.Context, shopperID string) (types.SignatureMetadata, error) {
 	route := fmt.Sprintf(consts.RouteGetSignatureMetadata, shopperID)
 
 	metadata := types.SignatureMetadata{}
 
-	err := p.ExecuteRequest(consts.HTTPGet, route, nil, &metadata)
+	err := p.ExecuteRequestContext(ctx, consts.HTTPGet, route, nil, &metadata)
 
 	return metadata, err
 }
 
-// Sign -
+// Sign signs the Solidity packed Keccak256 hash of the given values with the given hex encoded private key
 func (p *BasePaymentsClient) Sign(privateKey string, paramTypes []string, paramValues []string) (string, error) {
+	keySigner, err := signer.NewHexKeySigner(privateKey)
+
+	if err != nil {
+		return "", err
+	}
+
+	return p.SignWith(keySigner, paramTypes, paramValues)
+}
+
+// SignWith signs the Solidity packed Keccak256 hash of the given values with the given signer
+func (p *BasePaymentsClient) SignWith(s signer.Signer, paramTypes []string, paramValues []string) (string, error) {
+	return p.SignWithContext(context.Background(), s, paramTypes, paramValues)
+}
+
+// SignWithContext signs the Solidity packed Keccak256 hash of the given values with the given signer within the given context
+func (p *BasePaymentsClient) SignWithContext(ctx context.Context, s signer.Signer, paramTypes []string, paramValues []string) (string, error) {
+	hash, err := SoliditySHA3(paramTypes, paramValues)
+
+	if err != nil {
+		return "", err
+	}
 
-	pk, err := crypto.HexToECDSA(privateKey)
+	signature, err := s.SignHash(ctx, hash.Bytes())
 
 	if err != nil {
 		return "", err
 	}
 
-	params := append(paramTypes, paramValues...)
+	return hexutil.Encode(signature), nil
+}
 
-	var sb strings.Builder
+// SignAuthorization signs the authorization payload with the given signer,
+// according to the signing scheme of the client
+func (p *BasePaymentsClient) SignAuthorization(s signer.Signer, auth *Authorization) (string, error) {
+	return p.SignAuthorizationContext(context.Background(), s, auth)
+}
+
+// SignAuthorizationContext signs the authorization payload with the given signer within the given context
+func (p *BasePaymentsClient) SignAuthorizationContext(ctx context.Context, s signer.Signer, auth *Authorization) (string, error) {
+	scheme := p.Scheme
 
-	for _, el := range params {
-		sb.WriteString(el)
+	if scheme == nil {
+		scheme = RawScheme{}
 	}
 
-	bytes := []byte(sb.String())
+	hash, err := scheme.Hash(auth)
 
-	hash := crypto.Keccak256Hash(bytes)
+	if err != nil {
+		return "", err
+	}
 
-	signature, err := crypto.Sign(hash.Bytes(), pk)
+	signature, err := s.SignHash(ctx, hash.Bytes())
 
 	if err != nil {
 		return "", err
 	}
 
-	return hexutil.Encode(signature), nil
+	if len(signature) != 65 {
+		return "", fmt.Errorf("signer returned a %d byte signature", len(signature))
+	}
+
+	return encodeSignature(signature, scheme), nil
 }
 
 // ExecuteRequest -
 func (p *BasePaymentsClient) ExecuteRequest(method string, route string, data interface{}, model interface{}) error {
 	return p.Requester.ExecuteRequest(method, route, data, model)
 }
+
+// ExecuteRequestContext -
+func (p *BasePaymentsClient) ExecuteRequestContext(ctx context.Context, method string, route string, data interface{}, model interface{}) error {
+	return p.Requester.ExecuteRequestContext(ctx, method, route, data, model)
+}
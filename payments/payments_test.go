@@ -1,8 +1,10 @@
 package payments
 
 import (
+	"context"
 	"testing"
 
+	"github.com/LimePay/go-sdk/consts"
 	"github.com/LimePay/go-sdk/http"
 	"github.com/LimePay/go-sdk/test"
 	"github.com/LimePay/go-sdk/test/helper"
@@ -20,13 +22,13 @@ var paymentMock = types.Payment{
 	Items: []types.Item{
 		{
 			Description: "Some description",
-			LineAmount:  100.4,
+			LineAmount:  types.MustParseMoney("100.4", "USD"),
 			Quantity:    1,
 		},
 	},
 	FundTxData: types.FundTxData{
-		TokenAmount: "10000000000000000000",
-		WeiAmount:   "60000000000000000",
+		TokenAmount: types.MustParseAmount("10000000000000000000"),
+		WeiAmount:   types.MustParseAmount("60000000000000000"),
 	},
 	GenericTransactions: []types.GenericTransaction{
 		{
@@ -51,13 +53,13 @@ var paymentsMock = []types.Payment{
 		Items: []types.Item{
 			{
 				Description: "Some description",
-				LineAmount:  100.4,
+				LineAmount:  types.MustParseMoney("100.4", "USD"),
 				Quantity:    1,
 			},
 		},
 		FundTxData: types.FundTxData{
-			TokenAmount: "10000000000000000000",
-			WeiAmount:   "60000000000000000",
+			TokenAmount: types.MustParseAmount("10000000000000000000"),
+			WeiAmount:   types.MustParseAmount("60000000000000000"),
 		},
 		GenericTransactions: []types.GenericTransaction{
 			{
@@ -80,13 +82,13 @@ var paymentsMock = []types.Payment{
 		Items: []types.Item{
 			{
 				Description: "Another description",
-				LineAmount:  20.5,
+				LineAmount:  types.MustParseMoney("20.5", "USD"),
 				Quantity:    5,
 			},
 		},
 		FundTxData: types.FundTxData{
-			TokenAmount: "10000000000000000000",
-			WeiAmount:   "60000000000000000",
+			TokenAmount: types.MustParseAmount("10000000000000000000"),
+			WeiAmount:   types.MustParseAmount("60000000000000000"),
 		},
 		GenericTransactions: []types.GenericTransaction{
 			{
@@ -131,8 +133,8 @@ func TestGet(t *testing.T) {
 	et.Assert(paymentMock.Currency == payment.Currency, "Payment currency does not match")
 	et.Assert(paymentMock.Shopper == payment.Shopper, "Payment shopper does not match")
 	et.Assert(paymentMock.Vendor == payment.Vendor, "Payment vendor does not match")
-	et.Assert(paymentMock.FundTxData.TokenAmount == payment.FundTxData.TokenAmount, "Payment tokenAmount does not match")
-	et.Assert(paymentMock.FundTxData.WeiAmount == payment.FundTxData.WeiAmount, "Payment weiAmount does not match")
+	et.Assert(paymentMock.FundTxData.TokenAmount.Equal(payment.FundTxData.TokenAmount), "Payment tokenAmount does not match")
+	et.Assert(paymentMock.FundTxData.WeiAmount.Equal(payment.FundTxData.WeiAmount), "Payment weiAmount does not match")
 	et.Assert(paymentMock.Type == payment.Type, "Payment type does not match")
 	et.Assert(err == nil, "Not expected error expected")
 }
@@ -158,10 +160,143 @@ func TestGetAll(t *testing.T) {
 		et.Assert(paymentsMock[i].Currency == p.Currency, "Payment currency does not match")
 		et.Assert(paymentsMock[i].Shopper == p.Shopper, "Payment shopper does not match")
 		et.Assert(paymentsMock[i].Vendor == p.Vendor, "Payment vendor does not match")
-		et.Assert(paymentsMock[i].FundTxData.TokenAmount == p.FundTxData.TokenAmount, "Payment tokenAmount does not match")
-		et.Assert(paymentsMock[i].FundTxData.WeiAmount == p.FundTxData.WeiAmount, "Payment weiAmount does not match")
+		et.Assert(paymentsMock[i].FundTxData.TokenAmount.Equal(p.FundTxData.TokenAmount), "Payment tokenAmount does not match")
+		et.Assert(paymentsMock[i].FundTxData.WeiAmount.Equal(p.FundTxData.WeiAmount), "Payment weiAmount does not match")
 		et.Assert(paymentsMock[i].Type == p.Type, "Payment type does not match")
 	}
 
 	et.Assert(err == nil, "Not expected error returned")
 }
+
+func TestGetContext(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/payments/0").
+		Reply(200).
+		JSON(paymentMock)
+
+	paymentsClient := NewClient(http.NewRequester(test.Env, test.APIKey, test.APISecret))
+
+	payment, err := paymentsClient.GetContext(context.Background(), paymentMock.ID)
+
+	et.Assert(paymentMock.ID == payment.ID, "Payment ID does not match")
+	et.Assert(err == nil, "Not expected error returned")
+}
+
+func TestGetContextCanceled(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/payments/0").
+		Reply(200).
+		JSON(paymentMock)
+
+	paymentsClient := NewClient(http.NewRequester(test.Env, test.APIKey, test.APISecret))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := paymentsClient.GetContext(ctx, paymentMock.ID)
+
+	et.Assert(err != nil, "Expected error to be returned")
+}
+
+func TestCreatePaymentSendsIdempotencyKey(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Post("/payments").
+		HeaderPresent(consts.HeaderIdempotencyKey).
+		Reply(200).
+		JSON(paymentMock)
+
+	paymentsClient := NewClient(http.NewRequester(test.Env, test.APIKey, test.APISecret))
+
+	payment, err := paymentsClient.CreatePayment(consts.RouteCreateFiatPayment, paymentMock)
+
+	et.Assert(paymentMock.ID == payment.ID, "Payment ID does not match")
+	et.Assert(err == nil, "Not expected error returned")
+}
+
+func TestCreatePaymentContextWithIdempotencyKey(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Post("/payments/relayed").
+		MatchHeader(consts.HeaderIdempotencyKey, "key-123").
+		Reply(200).
+		JSON(paymentMock)
+
+	paymentsClient := NewClient(http.NewRequester(test.Env, test.APIKey, test.APISecret))
+
+	ctx := http.ContextWithIdempotencyKey(context.Background(), "key-123")
+
+	_, err := paymentsClient.CreatePaymentContext(ctx, consts.RouteCreateRelayedPayment, paymentMock)
+
+	et.Assert(err == nil, "Not expected error returned")
+}
+
+func TestList(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/payments").
+		MatchParam("limit", "2").
+		MatchParam("offset", "4").
+		Reply(200).
+		JSON(map[string]interface{}{"data": paymentsMock, "total": 6})
+
+	paymentsClient := NewClient(http.NewRequester(test.Env, test.APIKey, test.APISecret))
+
+	page, err := paymentsClient.List(types.PaymentListOptions{ListOptions: types.ListOptions{Limit: 2, Offset: 4}})
+
+	et.Assert(err == nil, "Not expected error returned")
+	et.Assert(len(page.Items) == 2, "Page items count does not match")
+	et.Assert(page.Total == 6, "Page total does not match")
+	et.Assert(page.Items[1].ID == paymentsMock[1].ID, "Payment ID does not match")
+}
+
+func TestAll(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/payments").
+		MatchParam("limit", "2").
+		MatchParam("status", "NEW").
+		Reply(200).
+		JSON(paymentsMock)
+
+	gock.New(test.Env).
+		Get("/payments").
+		MatchParam("limit", "2").
+		MatchParam("offset", "2").
+		MatchParam("status", "NEW").
+		Reply(200).
+		JSON(paymentsMock[:1])
+
+	paymentsClient := NewClient(http.NewRequester(test.Env, test.APIKey, test.APISecret))
+
+	ids := []string{}
+
+	for payment, err := range paymentsClient.All(context.Background(), types.PaymentListOptions{ListOptions: types.ListOptions{Limit: 2}, Status: "NEW"}) {
+		et.Assert(err == nil, "Not expected error returned")
+
+		ids = append(ids, payment.ID)
+	}
+
+	et.Assert(len(ids) == 3, "Expected payments of both pages")
+	et.Assert(gock.IsDone(), "Expected both pages to be fetched")
+}
@@ -0,0 +1,80 @@
+package payments
+
+import (
+	"math/big"
+
+	"github.com/LimePay/go-sdk/errors"
+	"github.com/LimePay/go-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// RelayedAuthorization is the payload signed to authorize a relayed payment.
+// The relayer contract verifies the signature over
+// keccak256(abi.encodePacked(nonce, escrow, shopper, weiAmount)).
+type RelayedAuthorization struct {
+	Nonce     *big.Int
+	Escrow    common.Address
+	Shopper   common.Address
+	WeiAmount *big.Int
+}
+
+// NewRelayedAuthorization parses and validates the relayed authorization payload
+// from the signature metadata of the shopper and the fund transaction data
+func NewRelayedAuthorization(signatureMetadata types.SignatureMetadata, fundTxData types.FundTxData) (*RelayedAuthorization, error) {
+	if !fundTxData.WeiAmount.IsSet() {
+		return nil, errors.InvalidWeiAmountProvided
+	}
+
+	nonce, ok := parseUint256(signatureMetadata.Nonce)
+
+	if !ok || !common.IsHexAddress(signatureMetadata.EscrowAddress) || !common.IsHexAddress(signatureMetadata.ShopperAddress) {
+		return nil, errors.InvalidSignatureMetadataError
+	}
+
+	auth := &RelayedAuthorization{
+		Nonce:     nonce,
+		Escrow:    common.HexToAddress(signatureMetadata.EscrowAddress),
+		Shopper:   common.HexToAddress(signatureMetadata.ShopperAddress),
+		WeiAmount: fundTxData.WeiAmount.BigInt(),
+	}
+
+	return auth, auth.Validate()
+}
+
+// Validate checks that the payload can be signed
+func (r *RelayedAuthorization) Validate() error {
+	if r.WeiAmount == nil || r.WeiAmount.Sign() <= 0 || r.WeiAmount.Cmp(maxUint256) > 0 {
+		return errors.InvalidWeiAmountProvided
+	}
+
+	if r.Nonce == nil || r.Nonce.Sign() < 0 || r.Nonce.Cmp(maxUint256) > 0 {
+		return errors.InvalidSignatureMetadataError
+	}
+
+	if r.Escrow == (common.Address{}) || r.Shopper == (common.Address{}) {
+		return errors.InvalidSignatureMetadataError
+	}
+
+	return nil
+}
+
+// Authorization returns the generic authorization of the payload
+func (r *RelayedAuthorization) Authorization() *Authorization {
+	return &Authorization{
+		PrimaryType:   "RelayedPayment",
+		Names:         []string{"nonce", "escrowAddress", "shopperAddress", "weiAmount"},
+		Types:         []string{"uint256", "address", "address", "uint256"},
+		Values:        []string{r.Nonce.String(), r.Escrow.Hex(), r.Shopper.Hex(), r.WeiAmount.String()},
+		EscrowAddress: r.Escrow.Hex(),
+	}
+}
+
+func parseUint256(value string) (*big.Int, bool) {
+	encoded, err := encodeUint256(value)
+
+	if err != nil {
+		return nil, false
+	}
+
+	return new(big.Int).SetBytes(encoded), true
+}
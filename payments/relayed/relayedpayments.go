@@ -1,22 +1,33 @@
 package relayed
 
 import (
+	"context"
+	"fmt"
+
 	"github.com/LimePay/go-sdk/consts"
 	"github.com/LimePay/go-sdk/errors"
 	"github.com/LimePay/go-sdk/http"
 	"github.com/LimePay/go-sdk/payments"
+	"github.com/LimePay/go-sdk/signer"
+	"github.com/LimePay/go-sdk/tracing"
 	"github.com/LimePay/go-sdk/types"
+	"go.opentelemetry.io/otel/trace"
 )
 
-var relayedSignatureValueTypes = []string{"uint256", "address", "address", "uint256"}
-
 type internalPaymentsClient interface {
 	payments.PaymentsClient
 
 	CreatePayment(route string, paymentData types.Payment) (*types.Payment, error)
+	CreatePaymentContext(ctx context.Context, route string, paymentData types.Payment) (*types.Payment, error)
 	GetSignatureMetadata(shopperID string) (types.SignatureMetadata, error)
+	GetSignatureMetadataContext(ctx context.Context, shopperID string) (types.SignatureMetadata, error)
 	Sign(privateKey string, paramTypes []string, paramValues []string) (string, error)
+	SignWith(s signer.Signer, paramTypes []string, paramValues []string) (string, error)
+	SignWithContext(ctx context.Context, s signer.Signer, paramTypes []string, paramValues []string) (string, error)
+	SignAuthorization(s signer.Signer, auth *payments.Authorization) (string, error)
+	SignAuthorizationContext(ctx context.Context, s signer.Signer, auth *payments.Authorization) (string, error)
 	ExecuteRequest(method string, route string, data interface{}, model interface{}) error
+	ExecuteRequestContext(ctx context.Context, method string, route string, data interface{}, model interface{}) error
 }
 
 // RelayedPaymentsClient -
@@ -27,47 +38,103 @@ type RelayedPaymentsClient interface {
 // BaseRelayedPaymentsClient -
 type BaseRelayedPaymentsClient struct {
 	internalPaymentsClient
+	tracerProvider trace.TracerProvider
 }
 
 // NewClient -
-func NewClient(requester http.Requester) *BaseRelayedPaymentsClient {
-	return &BaseRelayedPaymentsClient{payments.NewClient(requester)}
+func NewClient(requester http.Requester, opts ...payments.Option) *BaseRelayedPaymentsClient {
+	client := payments.NewClient(requester, opts...)
+
+	return &BaseRelayedPaymentsClient{client, client.TracerProvider}
 }
 
 // Create -
 func (r *BaseRelayedPaymentsClient) Create(paymentData types.Payment, privateKey string) (*types.Payment, error) {
-	payment := &types.Payment{}
+	return r.CreateContext(context.Background(), paymentData, privateKey)
+}
+
+// CreateContext -
+func (r *BaseRelayedPaymentsClient) CreateContext(ctx context.Context, paymentData types.Payment, privateKey string) (*types.Payment, error) {
+	var s signer.Signer
 
 	if paymentData.FundTxData.AuthorizationSignature == "" {
-		signatureMetadata, err := r.GetSignatureMetadata(paymentData.Shopper)
+		keySigner, err := signer.NewHexKeySigner(privateKey)
 
 		if err != nil {
-			return payment, err
+			return &types.Payment{}, errors.SigningError
 		}
 
-		authorizationSignature, errr := r.computeAuthorizationSignature(signatureMetadata, paymentData.FundTxData, privateKey)
+		s = keySigner
+	}
+
+	return r.CreateWithSignerContext(ctx, paymentData, s)
+}
 
-		if errr != nil {
-			return payment, errr
-		}
+// CreateWithSigner creates a payment, authorizing it with the given signer
+func (r *BaseRelayedPaymentsClient) CreateWithSigner(paymentData types.Payment, s signer.Signer) (*types.Payment, error) {
+	return r.CreateWithSignerContext(context.Background(), paymentData, s)
+}
 
-		paymentData.FundTxData.Nonce = signatureMetadata.Nonce
-		paymentData.FundTxData.AuthorizationSignature = authorizationSignature
+// CreateWithSignerContext creates a payment within the given context, authorizing it with the given signer
+func (r *BaseRelayedPaymentsClient) CreateWithSignerContext(ctx context.Context, paymentData types.Payment, s signer.Signer) (*types.Payment, error) {
+	if paymentData.FundTxData.Validate() != nil {
+		return &types.Payment{}, errors.InvalidAmountError
 	}
 
-	return r.CreatePayment(consts.RouteCreateRelayedPayment, paymentData)
+	payment := &types.Payment{}
+
+	err := tracing.Trace(ctx, r.tracerProvider, "limepay.relayed.Create", func(ctx context.Context) error {
+		if paymentData.FundTxData.AuthorizationSignature == "" {
+			signatureMetadata := types.SignatureMetadata{}
+
+			err := tracing.Trace(ctx, r.tracerProvider, "limepay.relayed.GetSignatureMetadata", func(ctx context.Context) (err error) {
+				signatureMetadata, err = r.GetSignatureMetadataContext(ctx, paymentData.Shopper)
+				return err
+			})
+
+			if err != nil {
+				return err
+			}
+
+			authorizationSignature := ""
+
+			err = tracing.Trace(ctx, r.tracerProvider, "limepay.relayed.Sign", func(ctx context.Context) (err error) {
+				authorizationSignature, err = r.computeAuthorizationSignature(ctx, signatureMetadata, paymentData.FundTxData, s)
+				return err
+			})
+
+			if err != nil {
+				return err
+			}
+
+			paymentData.FundTxData.Nonce = signatureMetadata.Nonce
+			paymentData.FundTxData.AuthorizationSignature = authorizationSignature
+		}
+
+		return tracing.Trace(ctx, r.tracerProvider, "limepay.relayed.CreatePayment", func(ctx context.Context) (err error) {
+			payment, err = r.CreatePaymentContext(ctx, consts.RouteCreateRelayedPayment, paymentData)
+			return err
+		})
+	})
+
+	return payment, err
 }
 
-func (r *BaseRelayedPaymentsClient) computeAuthorizationSignature(signatureMetadata types.SignatureMetadata, fundTxData types.FundTxData, privateKey string) (string, error) {
-	if fundTxData.WeiAmount == "" {
-		return "", errors.InvalidWeiAmountProvided
+func (r *BaseRelayedPaymentsClient) computeAuthorizationSignature(ctx context.Context, signatureMetadata types.SignatureMetadata, fundTxData types.FundTxData, s signer.Signer) (string, error) {
+	auth, err := payments.NewAuthorization(types.PaymentTypeRelayed, signatureMetadata, fundTxData)
+
+	if err != nil {
+		return "", err
 	}
 
-	res, err := r.Sign(privateKey, relayedSignatureValueTypes,
-		[]string{signatureMetadata.Nonce, signatureMetadata.EscrowAddress, signatureMetadata.ShopperAddress, "", fundTxData.WeiAmount})
+	if s == nil {
+		return "", errors.SigningError
+	}
+
+	res, err := r.SignAuthorizationContext(ctx, s, auth)
 
 	if err != nil {
-		return "", errors.SigningError
+		return "", fmt.Errorf("%w: %w", errors.SigningError, err)
 	}
 
 	return res, nil
@@ -1,20 +1,28 @@
 package relayed
 
 import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"io"
+	nethttp "net/http"
 	"testing"
 
 	"github.com/LimePay/go-sdk/errors"
 	"github.com/LimePay/go-sdk/http"
+	"github.com/LimePay/go-sdk/payments"
+	"github.com/LimePay/go-sdk/signer"
 	"github.com/LimePay/go-sdk/test"
 	"github.com/LimePay/go-sdk/test/helper"
 	"github.com/LimePay/go-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	gock "gopkg.in/h2non/gock.v1"
 )
 
 var shopperMetadataMock = types.SignatureMetadata{
 	Nonce:          "0",
-	ShopperAddress: "0x123",
-	EscrowAddress:  "0x321",
+	ShopperAddress: "0x8E8FD30C784BBb9B80877052AAE4bd9D43BCc034",
+	EscrowAddress:  "0x37688cFc875DC6AA6D39fE8449A759e434a86482",
 }
 
 var relayedPaymentMock = types.Payment{
@@ -27,13 +35,13 @@ var relayedPaymentMock = types.Payment{
 	Items: []types.Item{
 		{
 			Description: "Some description",
-			LineAmount:  100.4,
+			LineAmount:  types.MustParseMoney("100.4", "USD"),
 			Quantity:    1,
 		},
 	},
 	FundTxData: types.FundTxData{
-		TokenAmount: "10000000000000000000",
-		WeiAmount:   "60000000000000000",
+		TokenAmount: types.MustParseAmount("10000000000000000000"),
+		WeiAmount:   types.MustParseAmount("60000000000000000"),
 	},
 	GenericTransactions: []types.GenericTransaction{
 		{
@@ -85,8 +93,8 @@ func TestCreate(t *testing.T) {
 	et.Assert(relayedPaymentMock.Currency == res.Currency, "Relayed payment currency does not match")
 	et.Assert(relayedPaymentMock.Shopper == res.Shopper, "Relayed payment shopper does not match")
 	et.Assert(relayedPaymentMock.Vendor == res.Vendor, "Relayed payment vendor does not match")
-	et.Assert(relayedPaymentMock.FundTxData.TokenAmount == res.FundTxData.TokenAmount, "Relayed payment tokenAmount does not match")
-	et.Assert(relayedPaymentMock.FundTxData.WeiAmount == res.FundTxData.WeiAmount, "Relayed payment weiAmount does not match")
+	et.Assert(relayedPaymentMock.FundTxData.TokenAmount.Equal(res.FundTxData.TokenAmount), "Relayed payment tokenAmount does not match")
+	et.Assert(relayedPaymentMock.FundTxData.WeiAmount.Equal(res.FundTxData.WeiAmount), "Relayed payment weiAmount does not match")
 	et.Assert(relayedPaymentMock.Type == res.Type, "Relayed payment type does not match")
 	et.Assert(err == nil, "Not expected error expected")
 }
@@ -138,3 +146,174 @@ func TestCreateWithInvalidPrivateKey(t *testing.T) {
 	et.Assert(sdkError.ErrCode == errors.SigningError.ErrCode, "No matching error code")
 	et.Assert(sdkError.ErrMessage == errors.SigningError.ErrMessage, "No matching error message")
 }
+
+func TestCreateWithSigner(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/payments/metadata").
+		MatchParam("shopperId", "0").
+		Reply(200).
+		JSON(shopperMetadataMock)
+
+	gock.New(test.Env).
+		Post("/payments/relayed").
+		Reply(200).
+		JSON(relayedPaymentMock)
+
+	keySigner, _ := signer.NewHexKeySigner(privateKeyMock)
+
+	relayedPaymentsClient := NewClient(http.NewRequester(test.Env, test.APIKey, test.APISecret))
+
+	res, err := relayedPaymentsClient.CreateWithSigner(relayedPaymentMock, keySigner)
+
+	et.Assert(err == nil, "Not expected error expected")
+	et.Assert(relayedPaymentMock.ID == res.ID, "Relayed payment ID does not match")
+}
+
+func TestCreateWithNilSigner(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/payments/metadata").
+		MatchParam("shopperId", "0").
+		Reply(200).
+		JSON(shopperMetadataMock)
+
+	relayedPaymentsClient := NewClient(http.NewRequester(test.Env, test.APIKey, test.APISecret))
+
+	_, err := relayedPaymentsClient.CreateWithSigner(relayedPaymentMock, nil)
+
+	et.Assert(err == errors.SigningError, "Expected signing error to be returned")
+}
+
+func TestCreateWithSigningScheme(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/payments/metadata").
+		MatchParam("shopperId", "0").
+		Reply(200).
+		JSON(shopperMetadataMock)
+
+	sent := types.Payment{}
+
+	gock.New(test.Env).
+		Post("/payments/relayed").
+		AddMatcher(func(req *nethttp.Request, _ *gock.Request) (bool, error) {
+			body, err := io.ReadAll(req.Body)
+
+			if err != nil {
+				return false, err
+			}
+
+			return true, json.Unmarshal(body, &sent)
+		}).
+		Reply(200).
+		JSON(relayedPaymentMock)
+
+	keySigner, _ := signer.NewHexKeySigner(privateKeyMock)
+
+	relayedPaymentsClient := NewClient(http.NewRequester(test.Env, test.APIKey, test.APISecret), payments.WithSigningScheme(payments.EIP191Scheme{}))
+
+	_, err := relayedPaymentsClient.CreateWithSigner(relayedPaymentMock, keySigner)
+
+	et.Assert(err == nil, "Not expected error expected")
+
+	err = payments.VerifyAuthorizationSignatureWithScheme(payments.EIP191Scheme{}, sent, shopperMetadataMock, keySigner.Address())
+
+	et.Assert(err == nil, "Authorization should be signed with the EIP-191 scheme", err)
+}
+
+func TestCreateSendsRelayerSignature(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/payments/metadata").
+		MatchParam("shopperId", "0").
+		Reply(200).
+		JSON(shopperMetadataMock)
+
+	sent := types.Payment{}
+
+	gock.New(test.Env).
+		Post("/payments/relayed").
+		AddMatcher(func(req *nethttp.Request, _ *gock.Request) (bool, error) {
+			body, err := io.ReadAll(req.Body)
+
+			if err != nil {
+				return false, err
+			}
+
+			return true, json.Unmarshal(body, &sent)
+		}).
+		Reply(200).
+		JSON(relayedPaymentMock)
+
+	relayedPaymentsClient := NewClient(http.NewRequester(test.Env, test.APIKey, test.APISecret))
+
+	_, err := relayedPaymentsClient.Create(relayedPaymentMock, privateKeyMock)
+
+	et.Assert(err == nil, "Not expected error expected", err)
+	et.Assert(sent.FundTxData.Nonce == "0", "Relayed payment nonce does not match")
+	et.Assert(sent.FundTxData.AuthorizationSignature == "0x66068e0320fb292ee5a862732950b664dde0ae14af0e74f5025cc5bcdbd32d320b2ac2f9e5af7c8dc5c57b01423f160e3c9b481f886e0d22e377fa968e17ed4a01", "Relayed payment should carry the relayer signature")
+}
+
+func TestCreateWithInvalidSignatureMetadata(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/payments/metadata").
+		MatchParam("shopperId", "0").
+		Reply(200).
+		JSON(types.SignatureMetadata{Nonce: "0", ShopperAddress: "0x8E8FD30C784BBb9B80877052AAE4bd9D43BCc034"})
+
+	relayedPaymentsClient := NewClient(http.NewRequester(test.Env, test.APIKey, test.APISecret))
+
+	_, err := relayedPaymentsClient.Create(relayedPaymentMock, privateKeyMock)
+
+	et.Assert(err == errors.InvalidSignatureMetadataError, "Expected invalid signature metadata error", err)
+}
+
+type failingSigner struct {
+	err error
+}
+
+func (s failingSigner) Address() common.Address {
+	return common.Address{}
+}
+
+func (s failingSigner) SignHash(ctx context.Context, hash []byte) ([]byte, error) {
+	return nil, s.err
+}
+
+func TestCreateWrapsSignerError(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/payments/metadata").
+		MatchParam("shopperId", "0").
+		Reply(200).
+		JSON(shopperMetadataMock)
+
+	signerErr := stderrors.New("remote signer: unknown account (-32000)")
+
+	relayedPaymentsClient := NewClient(http.NewRequester(test.Env, test.APIKey, test.APISecret))
+
+	_, err := relayedPaymentsClient.CreateWithSigner(relayedPaymentMock, failingSigner{signerErr})
+
+	et.Assert(stderrors.Is(err, errors.SigningError), "Expected signing error to be returned", err)
+	et.Assert(stderrors.Is(err, signerErr), "Expected signer error to be wrapped", err)
+}
@@ -0,0 +1,103 @@
+package payments
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/LimePay/go-sdk/errors"
+	"github.com/LimePay/go-sdk/signer"
+	"github.com/LimePay/go-sdk/test/helper"
+	"github.com/LimePay/go-sdk/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Golden vectors of keccak256(abi.encodePacked(nonce, escrow, shopper, weiAmount))
+// as verified by the relayer contract, signed with the test private key
+var relayedGoldenVectors = []struct {
+	nonce     string
+	weiAmount string
+	packed    string
+	hash      string
+	signature string
+}{
+	{
+		nonce:     "0",
+		weiAmount: "60000000000000000",
+		packed: "0x0000000000000000000000000000000000000000000000000000000000000000" +
+			"37688cfc875dc6aa6d39fe8449a759e434a86482" +
+			"8e8fd30c784bbb9b80877052aae4bd9d43bcc034" +
+			"00000000000000000000000000000000000000000000000000d529ae9e860000",
+		hash:      "0x8f4056e654fece4267fe457880b45d087cc165e0794c5a076e20cae995dc624d",
+		signature: "0x66068e0320fb292ee5a862732950b664dde0ae14af0e74f5025cc5bcdbd32d320b2ac2f9e5af7c8dc5c57b01423f160e3c9b481f886e0d22e377fa968e17ed4a01",
+	},
+	{
+		nonce:     "7",
+		weiAmount: "1000000000000000000",
+		packed: "0x0000000000000000000000000000000000000000000000000000000000000007" +
+			"37688cfc875dc6aa6d39fe8449a759e434a86482" +
+			"8e8fd30c784bbb9b80877052aae4bd9d43bcc034" +
+			"0000000000000000000000000000000000000000000000000de0b6b3a7640000",
+		hash:      "0x6694414dc0ab2f56ca481f313c13569ef633fea0890587770a1f1eb2c66dcd69",
+		signature: "0xdc966b34ab6f82cb25e03bfa65879847e02bbdd878f0efede53949c3119c06bb507e3570779517270aa11b10e60bcf2b1c8f9a36515b67bbc70b72d82505768c00",
+	},
+}
+
+func TestRelayedAuthorizationGoldenVectors(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	keySigner, _ := signer.NewHexKeySigner("d723d3cdf932464de15845c0719ca13ce15e64c83625d86ddbfc217bd2ac5f5a")
+
+	for _, vector := range relayedGoldenVectors {
+		metadata := signatureMetadataMock
+		metadata.Nonce = vector.nonce
+
+		relayedAuth, err := NewRelayedAuthorization(metadata, types.FundTxData{WeiAmount: types.MustParseAmount(vector.weiAmount)})
+
+		et.Assert(err == nil, "Not expected error returned", vector.nonce)
+
+		auth := relayedAuth.Authorization()
+
+		packed, _ := EncodePacked(auth.Types, auth.Values)
+
+		et.Assert(hexutil.Encode(packed) == vector.packed, "Packed payload does not match", vector.nonce)
+
+		hash, _ := RawScheme{}.Hash(auth)
+
+		et.Assert(hash.Hex() == vector.hash, "Hash does not match", vector.nonce)
+
+		signature, err := (&BasePaymentsClient{}).SignAuthorization(keySigner, auth)
+
+		et.Assert(err == nil, "Not expected error returned", vector.nonce)
+		et.Assert(signature == vector.signature, "Signature does not match", vector.nonce)
+	}
+}
+
+func TestRelayedAuthorizationValidation(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	invalidMetadata := func(modify func(*types.SignatureMetadata)) types.SignatureMetadata {
+		metadata := signatureMetadataMock
+		modify(&metadata)
+		return metadata
+	}
+
+	cases := []struct {
+		name       string
+		metadata   types.SignatureMetadata
+		fundTxData types.FundTxData
+		err        error
+	}{
+		{"missing wei amount", signatureMetadataMock, types.FundTxData{}, errors.InvalidWeiAmountProvided},
+		{"negative wei amount", signatureMetadataMock, types.FundTxData{WeiAmount: types.NewAmount(big.NewInt(-1))}, errors.InvalidWeiAmountProvided},
+		{"zero wei amount", signatureMetadataMock, types.FundTxData{WeiAmount: types.MustParseAmount("0")}, errors.InvalidWeiAmountProvided},
+		{"invalid nonce", invalidMetadata(func(m *types.SignatureMetadata) { m.Nonce = "" }), fundTxDataMock, errors.InvalidSignatureMetadataError},
+		{"invalid escrow", invalidMetadata(func(m *types.SignatureMetadata) { m.EscrowAddress = "0x123" }), fundTxDataMock, errors.InvalidSignatureMetadataError},
+		{"zero shopper", invalidMetadata(func(m *types.SignatureMetadata) { m.ShopperAddress = "0x0000000000000000000000000000000000000000" }), fundTxDataMock, errors.InvalidSignatureMetadataError},
+	}
+
+	for _, c := range cases {
+		_, err := NewRelayedAuthorization(c.metadata, c.fundTxData)
+
+		et.Assert(err == c.err, "Error does not match", c.name, err)
+	}
+}
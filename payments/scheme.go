@@ -0,0 +1,153 @@
+package payments
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// SigningScheme defines the digest signed to authorize a payment and the
+// recovery byte of the resulting signature
+type SigningScheme interface {
+	Hash(auth *Authorization) (common.Hash, error)
+	RecoveryOffset() byte
+}
+
+// RawScheme signs the bare Solidity packed Keccak256 hash of the authorization,
+// with a 0/1 recovery byte. It is the default scheme.
+type RawScheme struct{}
+
+// Hash -
+func (RawScheme) Hash(auth *Authorization) (common.Hash, error) {
+	return SoliditySHA3(auth.Types, auth.Values)
+}
+
+// RecoveryOffset -
+func (RawScheme) RecoveryOffset() byte {
+	return 0
+}
+
+// EIP191Scheme signs the Solidity packed Keccak256 hash of the authorization
+// prefixed as an Ethereum signed message (personal_sign), with a 27/28 recovery byte
+type EIP191Scheme struct{}
+
+// Hash -
+func (EIP191Scheme) Hash(auth *Authorization) (common.Hash, error) {
+	hash, err := SoliditySHA3(auth.Types, auth.Values)
+
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return crypto.Keccak256Hash([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d", common.HashLength)), hash.Bytes()), nil
+}
+
+// RecoveryOffset -
+func (EIP191Scheme) RecoveryOffset() byte {
+	return 27
+}
+
+// EIP712Domain is the signing domain of EIP-712 typed data. Empty fields are
+// left out of the domain, except VerifyingContract which defaults to the
+// escrow address of the authorization.
+type EIP712Domain struct {
+	Name              string
+	Version           string
+	ChainID           *big.Int
+	VerifyingContract string
+}
+
+// EIP712Scheme signs the authorization as EIP-712 typed data, with a 27/28 recovery byte
+type EIP712Scheme struct {
+	Domain EIP712Domain
+}
+
+// Hash -
+func (s EIP712Scheme) Hash(auth *Authorization) (common.Hash, error) {
+	domainSeparator, err := s.domainSeparator(auth.EscrowAddress)
+
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	structHash, err := hashStruct(auth.TypeString(), auth.Types, auth.Values)
+
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return crypto.Keccak256Hash([]byte{0x19, 0x01}, domainSeparator.Bytes(), structHash.Bytes()), nil
+}
+
+// RecoveryOffset -
+func (EIP712Scheme) RecoveryOffset() byte {
+	return 27
+}
+
+func (s EIP712Scheme) domainSeparator(escrowAddress string) (common.Hash, error) {
+	names, paramTypes, paramValues := []string{}, []string{}, []string{}
+
+	if s.Domain.Name != "" {
+		names, paramTypes, paramValues = append(names, "name"), append(paramTypes, "string"), append(paramValues, s.Domain.Name)
+	}
+
+	if s.Domain.Version != "" {
+		names, paramTypes, paramValues = append(names, "version"), append(paramTypes, "string"), append(paramValues, s.Domain.Version)
+	}
+
+	if s.Domain.ChainID != nil {
+		names, paramTypes, paramValues = append(names, "chainId"), append(paramTypes, "uint256"), append(paramValues, s.Domain.ChainID.String())
+	}
+
+	verifyingContract := s.Domain.VerifyingContract
+
+	if verifyingContract == "" {
+		verifyingContract = escrowAddress
+	}
+
+	names, paramTypes, paramValues = append(names, "verifyingContract"), append(paramTypes, "address"), append(paramValues, verifyingContract)
+
+	domain := &Authorization{PrimaryType: "EIP712Domain", Names: names, Types: paramTypes}
+
+	return hashStruct(domain.TypeString(), paramTypes, paramValues)
+}
+
+// hashStruct computes the EIP-712 hashStruct of a struct made of atomic and dynamic members
+func hashStruct(typeString string, paramTypes []string, paramValues []string) (common.Hash, error) {
+	if len(paramTypes) != len(paramValues) {
+		return common.Hash{}, fmt.Errorf("eip712: %d types provided for %d values", len(paramTypes), len(paramValues))
+	}
+
+	encoded := crypto.Keccak256([]byte(typeString))
+
+	for i, paramType := range paramTypes {
+		value, err := encodePackedValue(paramType, paramValues[i])
+
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("eip712: invalid %s value at position %d: %v", paramType, i, err)
+		}
+
+		switch paramType {
+		case "string", "bytes":
+			value = crypto.Keccak256(value)
+		default:
+			value = common.LeftPadBytes(value, 32)
+		}
+
+		encoded = append(encoded, value...)
+	}
+
+	return crypto.Keccak256Hash(encoded), nil
+}
+
+// encodeSignature returns the hex encoded signature with the recovery byte
+// of the given scheme
+func encodeSignature(signature []byte, scheme SigningScheme) string {
+	signature = append([]byte{}, signature...)
+	signature[64] += scheme.RecoveryOffset()
+
+	return hexutil.Encode(signature)
+}
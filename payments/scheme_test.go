@@ -0,0 +1,115 @@
+package payments
+
+import (
+	stderrors "errors"
+	"math/big"
+	"testing"
+
+	"github.com/LimePay/go-sdk/errors"
+	"github.com/LimePay/go-sdk/signer"
+	"github.com/LimePay/go-sdk/test/helper"
+	"github.com/LimePay/go-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Mail example of the EIP-712 specification
+func TestEIP712MailExample(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	scheme := EIP712Scheme{EIP712Domain{
+		Name:              "Ether Mail",
+		Version:           "1",
+		ChainID:           big.NewInt(1),
+		VerifyingContract: "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC",
+	}}
+
+	domainSeparator, err := scheme.domainSeparator("")
+
+	et.Assert(err == nil, "Not expected error returned")
+	et.Assert(domainSeparator.Hex() == "0xf2cee375fa42b42143804025fc449deafd50cc031ca257e0b194a650a912090f", "Domain separator does not match", domainSeparator.Hex())
+
+	personType := "Person(string name,address wallet)"
+
+	from, _ := hashStruct(personType, []string{"string", "address"}, []string{"Cow", "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"})
+	to, _ := hashStruct(personType, []string{"string", "address"}, []string{"Bob", "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"})
+
+	et.Assert(from.Hex() == "0xfc71e5fa27ff56c350aa531bc129ebdf613b772b6604664f5d8dbe21b85eb0c8", "Person hash does not match", from.Hex())
+
+	mail, err := hashStruct("Mail(Person from,Person to,string contents)"+personType,
+		[]string{"bytes32", "bytes32", "string"}, []string{from.Hex(), to.Hex(), "Hello, Bob!"})
+
+	et.Assert(err == nil, "Not expected error returned")
+	et.Assert(mail.Hex() == "0xc52c0ee5d84264471806290a3f2c4cecfc5490626bf912d01f240d7a274b371e", "Mail hash does not match", mail.Hex())
+
+	digest := crypto.Keccak256Hash([]byte{0x19, 0x01}, domainSeparator.Bytes(), mail.Bytes())
+
+	et.Assert(digest.Hex() == "0xbe609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2", "Digest does not match", digest.Hex())
+}
+
+func TestEIP712DomainDefaultsToEscrow(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	scheme := EIP712Scheme{EIP712Domain{ChainID: big.NewInt(1)}}
+
+	withEscrow, _ := scheme.domainSeparator(signatureMetadataMock.EscrowAddress)
+
+	scheme.Domain.VerifyingContract = signatureMetadataMock.EscrowAddress
+
+	explicit, _ := scheme.domainSeparator("")
+
+	et.Assert(withEscrow == explicit, "Verifying contract should default to the escrow address")
+}
+
+func TestAuthorizationTypeString(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	auth, _ := NewAuthorization(types.PaymentTypeRelayed, signatureMetadataMock, fundTxDataMock)
+
+	et.Assert(auth.TypeString() == "RelayedPayment(uint256 nonce,address escrowAddress,address shopperAddress,uint256 weiAmount)", "Type string does not match", auth.TypeString())
+}
+
+func TestSigningSchemes(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	keySigner, _ := signer.NewHexKeySigner("d723d3cdf932464de15845c0719ca13ce15e64c83625d86ddbfc217bd2ac5f5a")
+
+	schemes := map[string]SigningScheme{
+		"raw":    RawScheme{},
+		"eip191": EIP191Scheme{},
+		"eip712": EIP712Scheme{EIP712Domain{Name: "LimePay", Version: "1", ChainID: big.NewInt(3)}},
+	}
+
+	for name, scheme := range schemes {
+		payment := signedPaymentWithSchemeMock(et, scheme, types.PaymentTypeFiat, keySigner)
+
+		v := hexutil.MustDecode(payment.FundTxData.AuthorizationSignature)[64]
+
+		et.Assert(v == scheme.RecoveryOffset() || v == scheme.RecoveryOffset()+1, "Recovery byte does not match", name, v)
+
+		err := VerifyAuthorizationSignatureWithScheme(scheme, payment, signatureMetadataMock, keySigner.Address())
+
+		et.Assert(err == nil, "Not expected error returned", name, err)
+
+		if name != "raw" {
+			err = VerifyAuthorizationSignature(payment, signatureMetadataMock, keySigner.Address())
+
+			et.Assert(stderrors.Is(err, errors.SignatureMismatchError), "Expected signature of another scheme to mismatch", name)
+		}
+	}
+}
+
+func TestEIP191Hash(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	auth, _ := NewAuthorization(types.PaymentTypeRelayed, signatureMetadataMock, fundTxDataMock)
+
+	raw, _ := RawScheme{}.Hash(auth)
+	prefixed, _ := EIP191Scheme{}.Hash(auth)
+
+	expected := crypto.Keccak256Hash(append([]byte("\x19Ethereum Signed Message:\n32"), raw.Bytes()...))
+
+	et.Assert(prefixed == expected, "Prefixed hash does not match")
+	et.Assert(prefixed != common.Hash{}, "Prefixed hash should not be empty")
+}
@@ -0,0 +1,81 @@
+package payments
+
+import (
+	"github.com/LimePay/go-sdk/errors"
+	"github.com/LimePay/go-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// RecoverAuthorizationSigner recovers the address which produced the authorization
+// signature of the payment under the raw signing scheme
+func RecoverAuthorizationSigner(payment types.Payment, signatureMetadata types.SignatureMetadata) (common.Address, error) {
+	return RecoverAuthorizationSignerWithScheme(RawScheme{}, payment, signatureMetadata)
+}
+
+// RecoverAuthorizationSignerWithScheme recovers the address which produced the
+// authorization signature of the payment under the given signing scheme. The
+// nonce stored in the payment's FundTxData takes precedence over the one in
+// metadata, so already submitted payments can be audited.
+func RecoverAuthorizationSignerWithScheme(scheme SigningScheme, payment types.Payment, signatureMetadata types.SignatureMetadata) (common.Address, error) {
+	if payment.FundTxData.Nonce != "" {
+		signatureMetadata.Nonce = payment.FundTxData.Nonce
+	}
+
+	auth, err := NewAuthorization(payment.Type, signatureMetadata, payment.FundTxData)
+
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	hash, err := scheme.Hash(auth)
+
+	if err != nil {
+		return common.Address{}, err
+	}
+
+	signature, err := hexutil.Decode(payment.FundTxData.AuthorizationSignature)
+
+	if err != nil || len(signature) != 65 {
+		return common.Address{}, errors.InvalidSignatureError
+	}
+
+	if signature[64] >= 27 {
+		signature[64] -= 27
+	}
+
+	pub, err := crypto.SigToPub(hash.Bytes(), signature)
+
+	if err != nil {
+		return common.Address{}, errors.InvalidSignatureError
+	}
+
+	return crypto.PubkeyToAddress(*pub), nil
+}
+
+// VerifyAuthorizationSignature checks that the authorization signature of the
+// payment was produced by expectedSigner under the raw signing scheme
+func VerifyAuthorizationSignature(payment types.Payment, signatureMetadata types.SignatureMetadata, expectedSigner common.Address) error {
+	return VerifyAuthorizationSignatureWithScheme(RawScheme{}, payment, signatureMetadata, expectedSigner)
+}
+
+// VerifyAuthorizationSignatureWithScheme checks that the authorization signature
+// of the payment was produced by expectedSigner under the given signing scheme.
+// A *errors.SignerMismatchError is returned when the recovered address differs.
+func VerifyAuthorizationSignatureWithScheme(scheme SigningScheme, payment types.Payment, signatureMetadata types.SignatureMetadata, expectedSigner common.Address) error {
+	recovered, err := RecoverAuthorizationSignerWithScheme(scheme, payment, signatureMetadata)
+
+	if err != nil {
+		return err
+	}
+
+	if recovered != expectedSigner {
+		return &errors.SignerMismatchError{
+			Expected:  expectedSigner.Hex(),
+			Recovered: recovered.Hex(),
+		}
+	}
+
+	return nil
+}
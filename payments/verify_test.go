@@ -0,0 +1,124 @@
+package payments
+
+import (
+	stderrors "errors"
+	"testing"
+
+	"github.com/LimePay/go-sdk/errors"
+	"github.com/LimePay/go-sdk/signer"
+	"github.com/LimePay/go-sdk/test/helper"
+	"github.com/LimePay/go-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var signatureMetadataMock = types.SignatureMetadata{
+	Nonce:          "0",
+	ShopperAddress: "0x8E8FD30C784BBb9B80877052AAE4bd9D43BCc034",
+	EscrowAddress:  "0x37688cFc875DC6AA6D39fE8449A759e434a86482",
+}
+
+var fundTxDataMock = types.FundTxData{
+	TokenAmount: types.MustParseAmount("10000000000000000000"),
+	WeiAmount:   types.MustParseAmount("60000000000000000"),
+	Nonce:       "0",
+}
+
+func signedPaymentMock(et *helper.ExtendedTesting, paymentType types.PaymentType, s signer.Signer) types.Payment {
+	return signedPaymentWithSchemeMock(et, RawScheme{}, paymentType, s)
+}
+
+func signedPaymentWithSchemeMock(et *helper.ExtendedTesting, scheme SigningScheme, paymentType types.PaymentType, s signer.Signer) types.Payment {
+	payment := types.Payment{
+		Type:       paymentType,
+		FundTxData: fundTxDataMock,
+	}
+
+	auth, err := NewAuthorization(paymentType, signatureMetadataMock, payment.FundTxData)
+
+	et.Assert(err == nil, "Not expected error returned")
+
+	payment.FundTxData.AuthorizationSignature, err = (&BasePaymentsClient{Scheme: scheme}).SignAuthorization(s, auth)
+
+	et.Assert(err == nil, "Not expected error returned")
+
+	return payment
+}
+
+func TestVerifyAuthorizationSignature(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	keySigner, _ := signer.NewHexKeySigner("d723d3cdf932464de15845c0719ca13ce15e64c83625d86ddbfc217bd2ac5f5a")
+
+	for _, paymentType := range []types.PaymentType{types.PaymentTypeFiat, types.PaymentTypeRelayed} {
+		payment := signedPaymentMock(et, paymentType, keySigner)
+
+		recovered, err := RecoverAuthorizationSigner(payment, signatureMetadataMock)
+
+		et.Assert(err == nil, "Not expected error returned", paymentType)
+		et.Assert(recovered == keySigner.Address(), "Recovered signer does not match", paymentType)
+
+		err = VerifyAuthorizationSignature(payment, signatureMetadataMock, keySigner.Address())
+
+		et.Assert(err == nil, "Not expected error returned", paymentType)
+	}
+}
+
+func TestVerifyAuthorizationSignatureUsesPaymentNonce(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	keySigner, _ := signer.NewHexKeySigner("d723d3cdf932464de15845c0719ca13ce15e64c83625d86ddbfc217bd2ac5f5a")
+
+	payment := signedPaymentMock(et, types.PaymentTypeFiat, keySigner)
+
+	metadata := signatureMetadataMock
+	metadata.Nonce = "1"
+
+	err := VerifyAuthorizationSignature(payment, metadata, keySigner.Address())
+
+	et.Assert(err == nil, "Expected nonce of the payment to take precedence")
+}
+
+func TestVerifyAuthorizationSignatureMismatch(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	keySigner, _ := signer.NewHexKeySigner("d723d3cdf932464de15845c0719ca13ce15e64c83625d86ddbfc217bd2ac5f5a")
+
+	expected := common.HexToAddress("0x8E8FD30C784BBb9B80877052AAE4bd9D43BCc034")
+
+	payment := signedPaymentMock(et, types.PaymentTypeRelayed, keySigner)
+
+	err := VerifyAuthorizationSignature(payment, signatureMetadataMock, expected)
+
+	et.Assert(stderrors.Is(err, errors.SignatureMismatchError), "Expected signature mismatch error")
+
+	mismatchErr := &errors.SignerMismatchError{}
+
+	et.Assert(stderrors.As(err, &mismatchErr), "Expected SignerMismatchError")
+	et.Assert(mismatchErr.Expected == expected.Hex(), "Expected signer does not match")
+	et.Assert(mismatchErr.Recovered == keySigner.Address().Hex(), "Recovered signer does not match")
+
+	payment.FundTxData.WeiAmount = types.MustParseAmount("70000000000000000")
+
+	err = VerifyAuthorizationSignature(payment, signatureMetadataMock, keySigner.Address())
+
+	et.Assert(stderrors.Is(err, errors.SignatureMismatchError), "Expected tampered payment to be reported")
+}
+
+func TestVerifyAuthorizationSignatureInvalid(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	keySigner, _ := signer.NewHexKeySigner("d723d3cdf932464de15845c0719ca13ce15e64c83625d86ddbfc217bd2ac5f5a")
+
+	payment := signedPaymentMock(et, types.PaymentTypeFiat, keySigner)
+	payment.FundTxData.AuthorizationSignature = "0x1234"
+
+	err := VerifyAuthorizationSignature(payment, signatureMetadataMock, keySigner.Address())
+
+	et.Assert(err == errors.InvalidSignatureError, "Expected invalid signature error")
+
+	payment.Type = "UNKNOWN_PAYMENT"
+
+	err = VerifyAuthorizationSignature(payment, signatureMetadataMock, keySigner.Address())
+
+	et.Assert(err == errors.UnsupportedPaymentTypeError, "Expected unsupported payment type error")
+}
@@ -0,0 +1,191 @@
+package payments
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"io"
+	"net"
+	"syscall"
+	"time"
+
+	"github.com/LimePay/go-sdk/errors"
+	"github.com/LimePay/go-sdk/types"
+)
+
+// Predicate reports whether WaitFor should stop polling the payment
+type Predicate func(payment *types.Payment) bool
+
+// Terminal is satisfied once the payment reaches a final status
+func Terminal(payment *types.Payment) bool {
+	return payment.Status.IsTerminal()
+}
+
+// StatusIn is satisfied once the payment reaches one of the given statuses
+func StatusIn(statuses ...types.PaymentStatus) Predicate {
+	return func(payment *types.Payment) bool {
+		for _, status := range statuses {
+			if payment.Status == status {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// WaitOptions configures how WaitFor polls the payment. The interval between
+// two polls starts at Interval and is multiplied by Multiplier after every
+// poll, up to MaxInterval. A positive Timeout bounds the whole wait. Polled
+// payments are observed by Tracker when set.
+type WaitOptions struct {
+	Interval    time.Duration
+	MaxInterval time.Duration
+	Multiplier  float64
+	Timeout     time.Duration
+	Tracker     *Tracker
+}
+
+// DefaultWaitOptions polls every second at first, backing off to every 30
+// seconds, for up to 10 minutes
+func DefaultWaitOptions() WaitOptions {
+	return WaitOptions{
+		Interval:    time.Second,
+		MaxInterval: 30 * time.Second,
+		Multiplier:  1.5,
+		Timeout:     10 * time.Minute,
+	}
+}
+
+// WaitOption -
+type WaitOption func(*WaitOptions)
+
+// WithPollInterval sets the interval between the first polls, which must be
+// positive
+func WithPollInterval(interval time.Duration) WaitOption {
+	return func(o *WaitOptions) {
+		o.Interval = interval
+	}
+}
+
+// WithPollBackoff grows the polling interval by multiplier after every poll, up to max
+func WithPollBackoff(multiplier float64, max time.Duration) WaitOption {
+	return func(o *WaitOptions) {
+		o.Multiplier = multiplier
+		o.MaxInterval = max
+	}
+}
+
+// WithWaitTimeout bounds the duration of the wait, zero meaning no bound other than ctx
+func WithWaitTimeout(timeout time.Duration) WaitOption {
+	return func(o *WaitOptions) {
+		o.Timeout = timeout
+	}
+}
+
+// WithTracker observes every polled payment with tracker, so its callbacks
+// receive the status changes. The wait stops with the tracker error on an
+// invalid transition or a failing callback.
+func WithTracker(tracker *Tracker) WaitOption {
+	return func(o *WaitOptions) {
+		o.Tracker = tracker
+	}
+}
+
+// WaitFor polls the payment until it satisfies predicate, returning it. When the
+// timeout elapses first, the last polled payment is returned along with an error
+// matching both errors.WaitTimeoutError and context.DeadlineExceeded, and
+// wrapping the error of the last poll if it failed. Retryable API errors and
+// temporary transport errors, i.e. network timeouts and dropped connections, do
+// not interrupt the wait.
+func (p *BasePaymentsClient) WaitFor(ctx context.Context, paymentID string, predicate Predicate, opts ...WaitOption) (*types.Payment, error) {
+	o := DefaultWaitOptions()
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if o.Interval <= 0 {
+		return nil, errors.InvalidWaitOptionsError
+	}
+
+	if o.Timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, o.Timeout)
+
+		defer cancel()
+	}
+
+	last := &types.Payment{}
+	interval := o.Interval
+
+	var lastErr error
+
+	for {
+		payment, err := p.GetContext(ctx, paymentID)
+
+		switch {
+		case err == nil:
+			last = payment
+			lastErr = nil
+
+			if o.Tracker != nil {
+				if _, err := o.Tracker.Observe(ctx, payment); err != nil {
+					return payment, err
+				}
+			}
+
+			if predicate(payment) {
+				return payment, nil
+			}
+		case ctx.Err() != nil:
+		case !isTemporary(err):
+			return last, err
+		default:
+			lastErr = err
+		}
+
+		timer := time.NewTimer(interval)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			if stderrors.Is(ctx.Err(), context.DeadlineExceeded) && lastErr != nil {
+				return last, fmt.Errorf("%w: %w: last poll: %w", errors.WaitTimeoutError, ctx.Err(), lastErr)
+			}
+
+			if stderrors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return last, fmt.Errorf("%w: %w", errors.WaitTimeoutError, ctx.Err())
+			}
+
+			return last, ctx.Err()
+		case <-timer.C:
+		}
+
+		if o.Multiplier > 1 {
+			interval = time.Duration(float64(interval) * o.Multiplier)
+		}
+
+		if o.MaxInterval > 0 && interval > o.MaxInterval {
+			interval = o.MaxInterval
+		}
+	}
+}
+
+// isTemporary reports whether polling may succeed again after err. Other
+// transport errors, e.g. an invalid URL or a TLS failure, are permanent.
+func isTemporary(err error) bool {
+	var netErr net.Error
+
+	if stderrors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	return errors.IsRetryable(err) ||
+		stderrors.Is(err, syscall.ECONNRESET) ||
+		stderrors.Is(err, syscall.ECONNABORTED) ||
+		stderrors.Is(err, io.ErrUnexpectedEOF) ||
+		stderrors.Is(err, io.EOF)
+}
@@ -0,0 +1,235 @@
+package payments
+
+import (
+	"context"
+	stderrors "errors"
+	"net"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/LimePay/go-sdk/errors"
+	"github.com/LimePay/go-sdk/http"
+	"github.com/LimePay/go-sdk/test"
+	"github.com/LimePay/go-sdk/test/helper"
+	"github.com/LimePay/go-sdk/types"
+	gock "gopkg.in/h2non/gock.v1"
+)
+
+var connectionReset = &net.OpError{Op: "read", Net: "tcp", Err: os.NewSyscallError("read", syscall.ECONNRESET)}
+
+func mockPaymentStatuses(statuses ...types.PaymentStatus) {
+	for _, status := range statuses {
+		gock.New(test.Env).
+			Get("/payments/0").
+			Reply(200).
+			JSON(types.Payment{ID: "0", Status: status})
+	}
+}
+
+func TestWaitFor(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	mockPaymentStatuses("NEW", "PROCESSING", "PROCESSING", "SUCCESSFUL")
+
+	paymentsClient := NewClient(http.NewRequester(test.Env, test.APIKey, test.APISecret))
+
+	payment, err := paymentsClient.WaitFor(context.Background(), "0", Terminal, WithPollInterval(time.Millisecond))
+
+	et.Assert(err == nil, "Not expected error returned", err)
+	et.Assert(payment.Status == "SUCCESSFUL", "Payment status does not match", payment.Status)
+	et.Assert(gock.IsDone(), "Payment should be polled until it reaches a terminal status")
+}
+
+func TestWaitForStatusIn(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	mockPaymentStatuses("NEW", "PROCESSING", "SUCCESSFUL")
+
+	paymentsClient := NewClient(http.NewRequester(test.Env, test.APIKey, test.APISecret))
+
+	payment, err := paymentsClient.WaitFor(context.Background(), "0", StatusIn("PROCESSING"), WithPollInterval(time.Millisecond))
+
+	et.Assert(err == nil, "Not expected error returned", err)
+	et.Assert(payment.Status == "PROCESSING", "Payment status does not match", payment.Status)
+	et.Assert(len(gock.Pending()) == 1, "Polling should stop once the predicate is satisfied")
+}
+
+func TestWaitForTimeout(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/payments/0").
+		Persist().
+		Reply(200).
+		JSON(types.Payment{ID: "0", Status: "PROCESSING"})
+
+	paymentsClient := NewClient(http.NewRequester(test.Env, test.APIKey, test.APISecret))
+
+	payment, err := paymentsClient.WaitFor(context.Background(), "0", Terminal,
+		WithPollInterval(time.Millisecond), WithPollBackoff(2, 5*time.Millisecond), WithWaitTimeout(30*time.Millisecond))
+
+	et.Assert(stderrors.Is(err, errors.WaitTimeoutError), "Expected wait timeout error", err)
+	et.Assert(stderrors.Is(err, context.DeadlineExceeded), "Expected deadline exceeded error", err)
+	et.Assert(payment.Status == "PROCESSING", "Last polled payment should be returned", payment.Status)
+}
+
+func TestWaitForCanceled(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/payments/0").
+		Persist().
+		Reply(200).
+		JSON(types.Payment{ID: "0", Status: "NEW"})
+
+	paymentsClient := NewClient(http.NewRequester(test.Env, test.APIKey, test.APISecret))
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	_, err := paymentsClient.WaitFor(ctx, "0", Terminal, WithPollInterval(time.Millisecond))
+
+	et.Assert(err == context.Canceled, "Expected canceled error", err)
+}
+
+func TestWaitForErrors(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	mockPaymentStatuses("NEW")
+
+	gock.New(test.Env).
+		Get("/payments/0").
+		Reply(503).
+		JSON(map[string]interface{}{"message": "Service unavailable"})
+
+	gock.New(test.Env).
+		Get("/payments/0").
+		Reply(404).
+		JSON(map[string]interface{}{"message": "Payment not found"})
+
+	paymentsClient := NewClient(http.NewRequester(test.Env, test.APIKey, test.APISecret))
+
+	payment, err := paymentsClient.WaitFor(context.Background(), "0", Terminal, WithPollInterval(time.Millisecond))
+
+	et.Assert(errors.IsNotFound(err), "Expected not retryable error to be returned", err)
+	et.Assert(payment.Status == "NEW", "Last polled payment should be returned", payment.Status)
+	et.Assert(gock.IsDone(), "Retryable errors should not interrupt the wait")
+}
+
+func TestWaitForWithTracker(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	mockPaymentStatuses("NEW", "PROCESSING", "PROCESSING", "SUCCESSFUL")
+
+	tracker := NewTracker()
+	transitions := []*Transition{}
+
+	tracker.OnTransition(func(ctx context.Context, transition *Transition) error {
+		transitions = append(transitions, transition)
+		return nil
+	})
+
+	paymentsClient := NewClient(http.NewRequester(test.Env, test.APIKey, test.APISecret))
+
+	_, err := paymentsClient.WaitFor(context.Background(), "0", Terminal, WithPollInterval(time.Millisecond), WithTracker(tracker))
+
+	et.Assert(err == nil, "Not expected error returned", err)
+	et.Assert(len(transitions) == 3, "Every status change should be emitted once", len(transitions))
+	et.Assert(transitions[2].From == "PROCESSING" && transitions[2].To == "SUCCESSFUL", "Last transition does not match")
+}
+
+func TestWaitForWithTrackerInvalidTransition(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	mockPaymentStatuses("PROCESSING", "NEW", "SUCCESSFUL")
+
+	paymentsClient := NewClient(http.NewRequester(test.Env, test.APIKey, test.APISecret))
+
+	payment, err := paymentsClient.WaitFor(context.Background(), "0", Terminal, WithPollInterval(time.Millisecond), WithTracker(NewTracker()))
+
+	et.Assert(stderrors.Is(err, errors.InvalidTransitionError), "Expected invalid transition error", err)
+	et.Assert(payment.Status == "NEW", "Offending payment should be returned", payment.Status)
+	et.Assert(len(gock.Pending()) == 1, "Polling should stop on an invalid transition")
+}
+
+func TestWaitForTransportErrors(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	mockPaymentStatuses("NEW")
+
+	gock.New(test.Env).
+		Get("/payments/0").
+		ReplyError(connectionReset)
+
+	mockPaymentStatuses("SUCCESSFUL")
+
+	paymentsClient := NewClient(http.NewRequester(test.Env, test.APIKey, test.APISecret))
+
+	payment, err := paymentsClient.WaitFor(context.Background(), "0", Terminal, WithPollInterval(time.Millisecond))
+
+	et.Assert(err == nil, "Transport errors should not interrupt the wait", err)
+	et.Assert(payment.Status == "SUCCESSFUL", "Payment status does not match", payment.Status)
+	et.Assert(gock.IsDone(), "Payment should be polled again after a transport error")
+}
+
+func TestWaitForPermanentTransportErrors(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	paymentsClient := NewClient(http.NewRequester("foo://bad", test.APIKey, test.APISecret))
+
+	_, err := paymentsClient.WaitFor(context.Background(), "0", Terminal, WithPollInterval(time.Millisecond), WithWaitTimeout(time.Second))
+
+	et.Assert(err != nil, "Expected transport error")
+	et.Assert(!stderrors.Is(err, errors.WaitTimeoutError), "Permanent transport errors should interrupt the wait", err)
+}
+
+func TestWaitForTimeoutWrapsLastError(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/payments/0").
+		Persist().
+		ReplyError(connectionReset)
+
+	paymentsClient := NewClient(http.NewRequester(test.Env, test.APIKey, test.APISecret))
+
+	_, err := paymentsClient.WaitFor(context.Background(), "0", Terminal,
+		WithPollInterval(time.Millisecond), WithWaitTimeout(30*time.Millisecond))
+
+	et.Assert(stderrors.Is(err, errors.WaitTimeoutError), "Expected wait timeout error", err)
+	et.Assert(stderrors.Is(err, syscall.ECONNRESET), "Timeout error should wrap the last poll error", err)
+}
+
+func TestWaitForInvalidInterval(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	paymentsClient := NewClient(http.NewRequester(test.Env, test.APIKey, test.APISecret))
+
+	_, err := paymentsClient.WaitFor(context.Background(), "0", Terminal, WithPollInterval(0))
+
+	et.Assert(stderrors.Is(err, errors.InvalidWaitOptionsError), "Expected invalid wait options error", err)
+	et.Assert(!gock.HasUnmatchedRequest(), "Payment should not be polled")
+}
@@ -18,19 +18,23 @@ type LimePaySDK struct {
 }
 
 // Connect connecting to LimePay API
-func Connect(env string, apiKey string, apiSecret string) (*LimePaySDK, error) {
-	requester := http.NewRequester(env, apiKey, apiSecret)
+func Connect(env string, apiKey string, apiSecret string, opts ...Option) (*LimePaySDK, error) {
+	config := newConfig(env, opts)
 
-	err := checkConnection(requester)
+	requester := http.NewRequester(config.baseURL, apiKey, apiSecret, config.requesterOptions...)
 
-	if err != nil {
-		return nil, err
+	if config.ping {
+		err := checkConnection(requester)
+
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	shoppers := shoppers.NewClient(requester)
-	payments := payments.NewClient(requester)
-	fiatPayments := fiat.NewClient(requester)
-	relayedPayments := relayed.NewClient(requester)
+	payments := payments.NewClient(requester, config.paymentsOptions...)
+	fiatPayments := fiat.NewClient(requester, config.paymentsOptions...)
+	relayedPayments := relayed.NewClient(requester, config.paymentsOptions...)
 
 	return &LimePaySDK{shoppers, payments, fiatPayments, relayedPayments}, nil
 }
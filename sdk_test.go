@@ -1,11 +1,15 @@
 package limepaysdk
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/LimePay/go-sdk/test"
 	"github.com/LimePay/go-sdk/test/helper"
 	"github.com/LimePay/go-sdk/types"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 	gock "gopkg.in/h2non/gock.v1"
 )
 
@@ -48,3 +52,80 @@ func TestConnectThrowingError(t *testing.T) {
 	et.Assert(limePaySDK == nil, "SDK instance should not be created")
 	et.Assert(err != nil, "Expected error to be returned")
 }
+
+func TestConnectWithoutPing(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/ping").
+		Reply(401).
+		JSON("Unauthorized request")
+
+	limePaySDK, err := Connect(test.Env, test.APIKey, test.APISecret, WithoutPing())
+
+	et.Assert(limePaySDK != nil, "SDK instance could not be created")
+	et.Assert(gock.IsPending(), "Ping should not be executed")
+	et.Assert(err == nil, "Not expected error returned")
+}
+
+func TestConnectWithOptions(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New("http://proxy-limepay-api.com").
+		Get("/ping").
+		MatchHeader("User-Agent", "test-agent").
+		Reply(200).
+		JSON(pingMock)
+
+	limePaySDK, err := Connect(test.Env, test.APIKey, test.APISecret,
+		WithBaseURL("http://proxy-limepay-api.com"),
+		WithUserAgent("test-agent"),
+		WithTimeout(5*time.Second))
+
+	et.Assert(limePaySDK != nil, "SDK instance could not be created")
+	et.Assert(gock.IsDone(), "Ping should be sent to the overridden base URL")
+	et.Assert(err == nil, "Not expected error returned")
+}
+
+func TestConnectWithTracing(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Post("/payments/relayed").
+		Reply(200).
+		JSON(types.Payment{ID: "0"})
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	limePaySDK, err := Connect(test.Env, test.APIKey, test.APISecret, WithoutPing(), WithTracing(tp))
+
+	et.Assert(err == nil, "Not expected error returned")
+
+	paymentData := types.Payment{
+		Shopper: "0",
+		FundTxData: types.FundTxData{
+			WeiAmount:              types.MustParseAmount("1000000000000000000"),
+			AuthorizationSignature: "0x00",
+		},
+	}
+
+	_, err = limePaySDK.RelayedPayments.CreateContext(context.Background(), paymentData, "")
+
+	names := map[string]sdktrace.ReadOnlySpan{}
+
+	for _, span := range recorder.Ended() {
+		names[span.Name()] = span
+	}
+
+	et.Assert(err == nil, "Not expected error returned", err)
+	et.Assert(len(names) == 3, "Expected create, step and request spans from the configured provider", len(names))
+	et.Assert(names["limepay.relayed.Create"] != nil && !names["limepay.relayed.Create"].Parent().IsValid(), "Create span should be a root span")
+	et.Assert(names["POST /payments/relayed"].Parent().SpanID() == names["limepay.relayed.CreatePayment"].SpanContext().SpanID(), "Request span is not a child of its step")
+}
@@ -1,11 +1,15 @@
 package shoppers
 
 import (
+	"context"
 	"fmt"
+	"iter"
+	"strings"
 
 	"github.com/LimePay/go-sdk/consts"
 	"github.com/LimePay/go-sdk/errors"
 	"github.com/LimePay/go-sdk/http"
+	"github.com/LimePay/go-sdk/pagination"
 	"github.com/LimePay/go-sdk/types"
 )
 
@@ -15,6 +19,21 @@ type ShoppersClient interface {
 	GetAll() (*[]types.Shopper, error)
 	Update(shopperID string, shopperData types.Shopper) (*types.Shopper, error)
 	GetWalletToken(shopperID string) (*types.WalletToken, error)
+
+	CreateContext(ctx context.Context, shopperData types.Shopper) (*types.Shopper, error)
+	GetContext(ctx context.Context, shopperID string) (*types.Shopper, error)
+	GetAllContext(ctx context.Context) (*[]types.Shopper, error)
+	UpdateContext(ctx context.Context, shopperID string, shopperData types.Shopper) (*types.Shopper, error)
+	GetWalletTokenContext(ctx context.Context, shopperID string) (*types.WalletToken, error)
+
+	List(opts types.ShopperListOptions) (*types.Page[types.Shopper], error)
+	ListContext(ctx context.Context, opts types.ShopperListOptions) (*types.Page[types.Shopper], error)
+	All(ctx context.Context, opts types.ShopperListOptions) iter.Seq2[types.Shopper, error]
+
+	FindByEmail(email string) (*types.Shopper, error)
+	FindByEmailContext(ctx context.Context, email string) (*types.Shopper, error)
+	FindByWalletAddress(walletAddress string) (*types.Shopper, error)
+	FindByWalletAddressContext(ctx context.Context, walletAddress string) (*types.Shopper, error)
 }
 
 // BaseShoppersClient provides functionality to consume Shoppers resource
@@ -29,22 +48,30 @@ func NewClient(requester http.Requester) *BaseShoppersClient {
 
 // Create registeres a new shopper
 func (s *BaseShoppersClient) Create(shopperData types.Shopper) (*types.Shopper, error) {
+	return s.CreateContext(context.Background(), shopperData)
+}
+
+// CreateContext registeres a new shopper within the given context.
+// An idempotency key is generated unless ctx already carries one.
+func (s *BaseShoppersClient) CreateContext(ctx context.Context, shopperData types.Shopper) (*types.Shopper, error) {
 	shopper := &types.Shopper{}
 
-	err := s.checkShopperVendor(&shopperData)
+	err := s.checkShopperVendor(ctx, &shopperData)
 
 	if err != nil {
 		return shopper, err
 	}
 
-	err = s.ExecuteRequest(consts.HTTPPost, consts.RouteCreateShopper, shopperData, shopper)
+	ctx = http.EnsureIdempotencyKey(ctx)
+
+	err = s.ExecuteRequestContext(ctx, consts.HTTPPost, consts.RouteCreateShopper, shopperData, shopper)
 
 	return shopper, err
 }
 
-func (s *BaseShoppersClient) checkShopperVendor(shopperData *types.Shopper) error {
+func (s *BaseShoppersClient) checkShopperVendor(ctx context.Context, shopperData *types.Shopper) error {
 	if shopperData.Vendor == "" {
-		vendors, err := s.getAllVendors()
+		vendors, err := s.getAllVendors(ctx)
 
 		if err != nil {
 			return err
@@ -61,52 +88,138 @@ func (s *BaseShoppersClient) checkShopperVendor(shopperData *types.Shopper) erro
 }
 
 // GetAllVendors retrieves all registered vendors
-func (s *BaseShoppersClient) getAllVendors() ([]types.Vendor, error) {
+func (s *BaseShoppersClient) getAllVendors(ctx context.Context) ([]types.Vendor, error) {
 	vendors := []types.Vendor{}
 
-	err := s.ExecuteRequest(consts.HTTPGet, consts.RouteGetAllVendors, nil, &vendors)
+	err := s.ExecuteRequestContext(ctx, consts.HTTPGet, consts.RouteGetAllVendors, nil, &vendors)
 
 	return vendors, err
 }
 
 // Get retrieves details for a given shopper
 func (s *BaseShoppersClient) Get(shopperID string) (*types.Shopper, error) {
+	return s.GetContext(context.Background(), shopperID)
+}
+
+// GetContext retrieves details for a given shopper within the given context
+func (s *BaseShoppersClient) GetContext(ctx context.Context, shopperID string) (*types.Shopper, error) {
 	route := fmt.Sprintf(consts.RouteGetShopper, shopperID)
 
 	shopper := &types.Shopper{}
 
-	err := s.ExecuteRequest(consts.HTTPGet, route, nil, shopper)
+	err := s.ExecuteRequestContext(ctx, consts.HTTPGet, route, nil, shopper)
 
 	return shopper, err
 }
 
 // GetAll retrieves all registered shoppers
 func (s *BaseShoppersClient) GetAll() (*[]types.Shopper, error) {
+	return s.GetAllContext(context.Background())
+}
+
+// GetAllContext retrieves all registered shoppers within the given context
+func (s *BaseShoppersClient) GetAllContext(ctx context.Context) (*[]types.Shopper, error) {
 	shoppers := &[]types.Shopper{}
 
-	err := s.ExecuteRequest(consts.HTTPGet, consts.RouteGetAllShoppers, nil, shoppers)
+	err := s.ExecuteRequestContext(ctx, consts.HTTPGet, consts.RouteGetAllShoppers, nil, shoppers)
 
 	return shoppers, err
 }
 
+// List retrieves a single page of registered shoppers matching the given filters
+func (s *BaseShoppersClient) List(opts types.ShopperListOptions) (*types.Page[types.Shopper], error) {
+	return s.ListContext(context.Background(), opts)
+}
+
+// ListContext retrieves a single page of registered shoppers matching the given filters within the given context
+func (s *BaseShoppersClient) ListContext(ctx context.Context, opts types.ShopperListOptions) (*types.Page[types.Shopper], error) {
+	route := http.RouteWithQuery(consts.RouteGetAllShoppers, opts.Query())
+
+	page := &types.Page[types.Shopper]{}
+
+	err := s.ExecuteRequestContext(ctx, consts.HTTPGet, route, nil, page)
+
+	return page, err
+}
+
+// All lazily iterates over all registered shoppers matching the given filters, fetching them page by page
+func (s *BaseShoppersClient) All(ctx context.Context, opts types.ShopperListOptions) iter.Seq2[types.Shopper, error] {
+	return pagination.All(ctx, opts.ListOptions, func(ctx context.Context, page types.ListOptions) (*types.Page[types.Shopper], error) {
+		opts.ListOptions = page
+
+		return s.ListContext(ctx, opts)
+	})
+}
+
+// FindByEmail retrieves the shopper registered with the given email
+func (s *BaseShoppersClient) FindByEmail(email string) (*types.Shopper, error) {
+	return s.FindByEmailContext(context.Background(), email)
+}
+
+// FindByEmailContext retrieves the shopper registered with the given email within the given context
+func (s *BaseShoppersClient) FindByEmailContext(ctx context.Context, email string) (*types.Shopper, error) {
+	return s.find(ctx, types.ShopperListOptions{Email: email}, func(shopper types.Shopper) bool {
+		return strings.EqualFold(shopper.Email, email)
+	})
+}
+
+// FindByWalletAddress retrieves the shopper owning the given wallet address
+func (s *BaseShoppersClient) FindByWalletAddress(walletAddress string) (*types.Shopper, error) {
+	return s.FindByWalletAddressContext(context.Background(), walletAddress)
+}
+
+// FindByWalletAddressContext retrieves the shopper owning the given wallet address within the given context
+func (s *BaseShoppersClient) FindByWalletAddressContext(ctx context.Context, walletAddress string) (*types.Shopper, error) {
+	return s.find(ctx, types.ShopperListOptions{WalletAddress: walletAddress}, func(shopper types.Shopper) bool {
+		return strings.EqualFold(shopper.WalletAddress, walletAddress)
+	})
+}
+
+// find returns the first listed shopper satisfying match. Listed shoppers are
+// checked locally as well, so lookups stay correct even if a filter is not
+// applied by the API.
+func (s *BaseShoppersClient) find(ctx context.Context, opts types.ShopperListOptions, match func(types.Shopper) bool) (*types.Shopper, error) {
+	for shopper, err := range s.All(ctx, opts) {
+		if err != nil {
+			return &types.Shopper{}, err
+		}
+
+		if match(shopper) {
+			return &shopper, nil
+		}
+	}
+
+	return &types.Shopper{}, errors.ShopperNotFoundError
+}
+
 // Update updates details of a given shopper
 func (s *BaseShoppersClient) Update(shopperID string, shopperData types.Shopper) (*types.Shopper, error) {
+	return s.UpdateContext(context.Background(), shopperID, shopperData)
+}
+
+// UpdateContext updates details of a given shopper within the given context
+func (s *BaseShoppersClient) UpdateContext(ctx context.Context, shopperID string, shopperData types.Shopper) (*types.Shopper, error) {
 	route := fmt.Sprintf(consts.RoutePatchShopper, shopperID)
 
 	shopper := &types.Shopper{}
 
-	err := s.ExecuteRequest(consts.HTTPPatch, route, shopperData, shopper)
+	err := s.ExecuteRequestContext(ctx, consts.HTTPPatch, route, shopperData, shopper)
 
 	return shopper, err
 }
 
 // GetWalletToken retrieves generated JSON wallet token for a given shopper
 func (s *BaseShoppersClient) GetWalletToken(shopperID string) (*types.WalletToken, error) {
+	return s.GetWalletTokenContext(context.Background(), shopperID)
+}
+
+// GetWalletTokenContext retrieves generated JSON wallet token for a given shopper within the given context
+func (s *BaseShoppersClient) GetWalletTokenContext(ctx context.Context, shopperID string) (*types.WalletToken, error) {
 	route := fmt.Sprintf(consts.RouteGetWalletToken, shopperID)
 
 	walletToken := &types.WalletToken{}
 
-	err := s.ExecuteRequest(consts.HTTPGet, route, nil, walletToken)
+	err := s.ExecuteRequestContext(ctx, consts.HTTPGet, route, nil, walletToken)
 
 	return walletToken, err
 }
@@ -115,3 +228,8 @@ func (s *BaseShoppersClient) GetWalletToken(shopperID string) (*types.WalletToke
 func (s *BaseShoppersClient) ExecuteRequest(method string, route string, data interface{}, model interface{}) error {
 	return s.requester.ExecuteRequest(method, route, data, model)
 }
+
+// ExecuteRequestContext -
+func (s *BaseShoppersClient) ExecuteRequestContext(ctx context.Context, method string, route string, data interface{}, model interface{}) error {
+	return s.requester.ExecuteRequestContext(ctx, method, route, data, model)
+}
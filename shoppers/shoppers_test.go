@@ -1,8 +1,12 @@
 package shoppers
 
 import (
+	"context"
+	"strconv"
 	"testing"
+	"time"
 
+	"github.com/LimePay/go-sdk/consts"
 	"github.com/LimePay/go-sdk/errors"
 	"github.com/LimePay/go-sdk/http"
 	"github.com/LimePay/go-sdk/test"
@@ -147,6 +151,27 @@ func TestCreateWithSpecifyingVendor(t *testing.T) {
 	et.Assert(err == nil, "Not expected error returned")
 }
 
+func TestCreateContextWithIdempotencyKey(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Post("/shoppers").
+		MatchHeader(consts.HeaderIdempotencyKey, "key-123").
+		Reply(200).
+		JSON(shopperMock)
+
+	shoppersClient := NewClient(http.NewRequester(test.Env, test.APIKey, test.APISecret))
+
+	ctx := http.ContextWithIdempotencyKey(context.Background(), "key-123")
+
+	res, err := shoppersClient.CreateContext(ctx, types.Shopper{Vendor: "vendor123"})
+
+	et.Assert(shopperMock.ID == res.ID, "Shopper ID does not match")
+	et.Assert(err == nil, "Not expected error returned")
+}
+
 func TestCreateWithoutSpecifyingVendor(t *testing.T) {
 	et := helper.WrapTesting(t)
 
@@ -304,3 +329,154 @@ func TestGetWalletToken(t *testing.T) {
 	et.Assert(walletTokenMock.WalletToken == res.WalletToken, "Wallet token does not match")
 	et.Assert(err == nil, "Not expected error returned")
 }
+
+func TestAll(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/shoppers").
+		MatchParam("limit", "1").
+		Reply(200).
+		JSON(map[string]interface{}{"data": shoppersMock[:1], "nextCursor": "next"})
+
+	gock.New(test.Env).
+		Get("/shoppers").
+		MatchParam("limit", "1").
+		MatchParam("cursor", "next").
+		Reply(200).
+		JSON(map[string]interface{}{"data": shoppersMock[1:2]})
+
+	shoppersClient := NewClient(http.NewRequester(test.Env, test.APIKey, test.APISecret))
+
+	ids := []string{}
+
+	for shopper, err := range shoppersClient.All(context.Background(), types.ShopperListOptions{ListOptions: types.ListOptions{Limit: 1}}) {
+		et.Assert(err == nil, "Not expected error returned")
+
+		ids = append(ids, shopper.ID)
+	}
+
+	et.Assert(len(ids) == 2, "Expected shoppers of both pages")
+	et.Assert(ids[0] == shoppersMock[0].ID && ids[1] == shoppersMock[1].ID, "Shopper IDs do not match")
+}
+
+func TestFindByEmail(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/shoppers").
+		MatchParam("email", shoppersMock[1].Email).
+		Reply(200).
+		JSON(shoppersMock[1:2])
+
+	shoppersClient := NewClient(http.NewRequester(test.Env, test.APIKey, test.APISecret))
+
+	shopper, err := shoppersClient.FindByEmail(shoppersMock[1].Email)
+
+	et.Assert(err == nil, "Not expected error returned")
+	et.Assert(shopper.ID == shoppersMock[1].ID, "Shopper ID does not match")
+}
+
+func TestFindByWalletAddressIgnoresCase(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/shoppers").
+		MatchParam("walletAddress", "0x8e8fd30c784bbb9b80877052aae4bd9d43bcc034").
+		Reply(200).
+		JSON([]types.Shopper{shopperMock})
+
+	shoppersClient := NewClient(http.NewRequester(test.Env, test.APIKey, test.APISecret))
+
+	shopper, err := shoppersClient.FindByWalletAddress("0x8e8fd30c784bbb9b80877052aae4bd9d43bcc034")
+
+	et.Assert(err == nil, "Not expected error returned")
+	et.Assert(shopper.ID == shopperMock.ID, "Shopper ID does not match")
+}
+
+func TestFindByEmailNotFound(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/shoppers").
+		MatchParam("email", "missing@abv.bg").
+		Reply(200).
+		JSON([]types.Shopper{})
+
+	shoppersClient := NewClient(http.NewRequester(test.Env, test.APIKey, test.APISecret))
+
+	_, err := shoppersClient.FindByEmail("missing@abv.bg")
+
+	et.Assert(err == errors.ShopperNotFoundError, "Expected shopper not found error")
+	et.Assert(errors.IsNotFound(err), "Expected not found error")
+}
+
+func unfilteredShoppersMock(from, count int) []types.Shopper {
+	shoppers := []types.Shopper{}
+
+	for i := from; i < from+count; i++ {
+		shoppers = append(shoppers, types.Shopper{ID: strconv.Itoa(i), Email: "shopper" + strconv.Itoa(i) + "@abv.bg"})
+	}
+
+	return shoppers
+}
+
+func TestFindByEmailNotFoundAcrossPages(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/shoppers").
+		MatchParam("offset", "100").
+		Reply(200).
+		JSON(unfilteredShoppersMock(100, 100))
+
+	gock.New(test.Env).
+		Get("/shoppers").
+		MatchParam("offset", "200").
+		Reply(200).
+		JSON(unfilteredShoppersMock(200, 20))
+
+	gock.New(test.Env).
+		Get("/shoppers").
+		MatchParam("email", "missing@abv.bg").
+		Reply(200).
+		JSON(unfilteredShoppersMock(0, 100))
+
+	shoppersClient := NewClient(http.NewRequester(test.Env, test.APIKey, test.APISecret))
+
+	_, err := shoppersClient.FindByEmail("missing@abv.bg")
+
+	et.Assert(err == errors.ShopperNotFoundError, "Expected shopper not found error", err)
+	et.Assert(gock.IsDone(), "Expected every page to be scanned")
+}
+
+func TestFindByEmailNotFoundWhenPagingIsIgnored(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/shoppers").
+		Persist().
+		Reply(200).
+		JSON(unfilteredShoppersMock(0, 150))
+
+	shoppersClient := NewClient(http.NewRequester(test.Env, test.APIKey, test.APISecret))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := shoppersClient.FindByEmailContext(ctx, "missing@abv.bg")
+
+	et.Assert(err == errors.ShopperNotFoundError, "Expected shopper not found error", err)
+}
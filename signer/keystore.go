@@ -0,0 +1,30 @@
+package signer
+
+import (
+	"io/ioutil"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+)
+
+// NewKeystoreSigner creates a KeySigner from a go-ethereum keystore (V3) JSON
+// encrypted with the given passphrase
+func NewKeystoreSigner(keyJSON []byte, passphrase string) (*KeySigner, error) {
+	key, err := keystore.DecryptKey(keyJSON, passphrase)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewKeySigner(key.PrivateKey), nil
+}
+
+// NewKeystoreFileSigner creates a KeySigner from a go-ethereum keystore file
+func NewKeystoreFileSigner(path string, passphrase string) (*KeySigner, error) {
+	keyJSON, err := ioutil.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewKeystoreSigner(keyJSON, passphrase)
+}
@@ -0,0 +1,66 @@
+package signer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/LimePay/go-sdk/test/helper"
+)
+
+// PBKDF2 test vector of the Web3 Secret Storage Definition
+var keystoreMock = `{
+	"crypto": {
+		"cipher": "aes-128-ctr",
+		"cipherparams": {"iv": "6087dab2f9fdbbfaddc31a909735c1e6"},
+		"ciphertext": "5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46",
+		"kdf": "pbkdf2",
+		"kdfparams": {
+			"c": 262144,
+			"dklen": 32,
+			"prf": "hmac-sha256",
+			"salt": "ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"
+		},
+		"mac": "517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"
+	},
+	"id": "3198bc9c-6672-5ab3-d995-4942343ae5b6",
+	"version": 3
+}`
+
+var keystorePassphraseMock = "testpassword"
+
+var keystoreAddressMock = "0x008AeEda4D805471dF9b2A5B0f38A0C3bCBA786b"
+
+func TestKeystoreSigner(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	keystoreSigner, err := NewKeystoreSigner([]byte(keystoreMock), keystorePassphraseMock)
+
+	et.Assert(err == nil, "Not expected error returned")
+	et.Assert(keystoreSigner.Address().Hex() == keystoreAddressMock, "Signer address does not match", keystoreSigner.Address().Hex())
+}
+
+func TestKeystoreSignerWithWrongPassphrase(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	_, err := NewKeystoreSigner([]byte(keystoreMock), "wrong")
+
+	et.Assert(err != nil, "Expected error to be returned")
+}
+
+func TestKeystoreFileSigner(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	path := filepath.Join(t.TempDir(), "keystore.json")
+
+	os.WriteFile(path, []byte(keystoreMock), 0600)
+
+	keystoreSigner, err := NewKeystoreFileSigner(path, keystorePassphraseMock)
+
+	et.Assert(err == nil, "Not expected error returned")
+	et.Assert(keystoreSigner.Address().Hex() == keystoreAddressMock, "Signer address does not match")
+
+	_, err = NewKeystoreFileSigner(filepath.Join(t.TempDir(), "missing.json"), keystorePassphraseMock)
+
+	et.Assert(err != nil, "Expected error to be returned")
+}
@@ -0,0 +1,124 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// DefaultRemoteMethod is the JSON-RPC method called by RemoteSigner. It takes
+// the signer address and the hash as hex strings and returns the hex signature.
+const DefaultRemoteMethod = "limepay_signHash"
+
+// RemoteSigner delegates signing to a dedicated signing process exposing a
+// JSON-RPC 2.0 endpoint over HTTP, so the private key never reaches the application
+type RemoteSigner struct {
+	url     string
+	address common.Address
+	method  string
+	client  *http.Client
+}
+
+type rpcRequest struct {
+	JSONRPC string        `json:"jsonrpc"`
+	ID      int           `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+type rpcResponse struct {
+	Result string    `json:"result"`
+	Error  *rpcError `json:"error"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *rpcError) Error() string {
+	return fmt.Sprintf("remote signer: %s (%d)", e.Message, e.Code)
+}
+
+// NewRemoteSigner creates a signer calling DefaultRemoteMethod on the given endpoint
+func NewRemoteSigner(url string, address common.Address) *RemoteSigner {
+	return NewRemoteSignerWithClient(url, address, DefaultRemoteMethod, &http.Client{Timeout: 10 * time.Second})
+}
+
+// NewRemoteSignerWithClient creates a signer calling the given method through the given client
+func NewRemoteSignerWithClient(url string, address common.Address, method string, client *http.Client) *RemoteSigner {
+	return &RemoteSigner{url, address, method, client}
+}
+
+// Address -
+func (s *RemoteSigner) Address() common.Address {
+	return s.address
+}
+
+// SignHash -
+func (s *RemoteSigner) SignHash(ctx context.Context, hash []byte) ([]byte, error) {
+	body, err := json.Marshal(rpcRequest{
+		JSONRPC: "2.0",
+		ID:      1,
+		Method:  s.method,
+		Params:  []interface{}{s.address.Hex(), hexutil.Encode(hash)},
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("remote signer: unexpected status %d", resp.StatusCode)
+	}
+
+	rpcResp := rpcResponse{}
+
+	err = json.NewDecoder(resp.Body).Decode(&rpcResp)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if rpcResp.Error != nil {
+		return nil, rpcResp.Error
+	}
+
+	signature, err := hexutil.Decode(rpcResp.Result)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(signature) != 65 {
+		return nil, fmt.Errorf("remote signer: invalid signature length %d", len(signature))
+	}
+
+	if signature[64] >= 27 {
+		signature[64] -= 27
+	}
+
+	return signature, nil
+}
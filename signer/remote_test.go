@@ -0,0 +1,97 @@
+package signer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/LimePay/go-sdk/test/helper"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func newRemoteSignerServer(et *helper.ExtendedTesting, keySigner *KeySigner) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := rpcRequest{}
+
+		json.NewDecoder(r.Body).Decode(&req)
+
+		et.Assert(req.JSONRPC == "2.0", "JSON-RPC version does not match")
+		et.Assert(req.Method == DefaultRemoteMethod, "JSON-RPC method does not match")
+
+		if req.Params[0] != keySigner.Address().Hex() {
+			json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "error": rpcError{-32000, "unknown account"}})
+			return
+		}
+
+		signature, _ := keySigner.SignHash(context.Background(), hexutil.MustDecode(req.Params[1].(string)))
+		signature[64] += 27
+
+		json.NewEncoder(w).Encode(map[string]interface{}{"jsonrpc": "2.0", "id": req.ID, "result": hexutil.Encode(signature)})
+	}))
+}
+
+func TestRemoteSigner(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	keySigner, _ := NewHexKeySigner(privateKeyMock)
+
+	server := newRemoteSignerServer(et, keySigner)
+	defer server.Close()
+
+	remoteSigner := NewRemoteSigner(server.URL, keySigner.Address())
+
+	signature, err := remoteSigner.SignHash(context.Background(), hashMock)
+
+	et.Assert(err == nil, "Not expected error returned")
+	et.Assert(signature[64] < 2, "Recovery byte should be normalized to 0 or 1")
+
+	pub, err := crypto.SigToPub(hashMock, signature)
+
+	et.Assert(err == nil, "Signature could not be recovered")
+	et.Assert(crypto.PubkeyToAddress(*pub) == remoteSigner.Address(), "Recovered signer does not match")
+}
+
+func TestRemoteSignerWithRPCError(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	keySigner, _ := NewHexKeySigner(privateKeyMock)
+
+	server := newRemoteSignerServer(et, keySigner)
+	defer server.Close()
+
+	otherSigner, _ := NewHexKeySigner("1111111111111111111111111111111111111111111111111111111111111111")
+
+	_, err := NewRemoteSigner(server.URL, otherSigner.Address()).SignHash(context.Background(), hashMock)
+
+	et.Assert(err != nil, "Expected error to be returned")
+	et.Assert(err.Error() == "remote signer: unknown account (-32000)", "Error message does not match")
+}
+
+func TestRemoteSignerHonorsContext(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	release := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	keySigner, _ := NewHexKeySigner(privateKeyMock)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+
+	_, err := NewRemoteSigner(server.URL, keySigner.Address()).SignHash(ctx, hashMock)
+
+	et.Assert(errors.Is(err, context.DeadlineExceeded), "Expected context deadline error", err)
+	et.Assert(time.Since(start) < 5*time.Second, "Signing should stop once the context is done")
+}
@@ -0,0 +1,50 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Signer signs payment authorization hashes on behalf of an Ethereum account.
+// SignHash returns a 65 bytes [R || S || V] signature with V being 0 or 1,
+// like crypto.Sign does. Signers reaching out to other processes are to stop
+// once ctx is done.
+type Signer interface {
+	Address() common.Address
+	SignHash(ctx context.Context, hash []byte) ([]byte, error)
+}
+
+// KeySigner signs with an in-memory ECDSA private key
+type KeySigner struct {
+	key     *ecdsa.PrivateKey
+	address common.Address
+}
+
+// NewKeySigner -
+func NewKeySigner(key *ecdsa.PrivateKey) *KeySigner {
+	return &KeySigner{key, crypto.PubkeyToAddress(key.PublicKey)}
+}
+
+// NewHexKeySigner creates a KeySigner from a hex encoded private key
+func NewHexKeySigner(privateKey string) (*KeySigner, error) {
+	key, err := crypto.HexToECDSA(privateKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return NewKeySigner(key), nil
+}
+
+// Address -
+func (s *KeySigner) Address() common.Address {
+	return s.address
+}
+
+// SignHash -
+func (s *KeySigner) SignHash(ctx context.Context, hash []byte) ([]byte, error) {
+	return crypto.Sign(hash, s.key)
+}
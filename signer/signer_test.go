@@ -0,0 +1,41 @@
+package signer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/LimePay/go-sdk/test/helper"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var privateKeyMock = "d723d3cdf932464de15845c0719ca13ce15e64c83625d86ddbfc217bd2ac5f5a"
+
+var hashMock = crypto.Keccak256([]byte("limepay"))
+
+func TestKeySigner(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	key, _ := crypto.HexToECDSA(privateKeyMock)
+
+	keySigner, err := NewHexKeySigner(privateKeyMock)
+
+	et.Assert(err == nil, "Not expected error returned")
+	et.Assert(keySigner.Address() == crypto.PubkeyToAddress(key.PublicKey), "Signer address does not match")
+
+	signature, err := keySigner.SignHash(context.Background(), hashMock)
+
+	et.Assert(err == nil, "Not expected error returned")
+
+	pub, err := crypto.SigToPub(hashMock, signature)
+
+	et.Assert(err == nil, "Signature could not be recovered")
+	et.Assert(crypto.PubkeyToAddress(*pub) == keySigner.Address(), "Recovered signer does not match")
+}
+
+func TestHexKeySignerWithInvalidKey(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	_, err := NewHexKeySigner("0x123")
+
+	et.Assert(err != nil, "Expected error to be returned")
+}
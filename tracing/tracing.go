@@ -0,0 +1,106 @@
+package tracing
+
+import (
+	"context"
+	stderrors "errors"
+
+	"github.com/LimePay/go-sdk/errors"
+	"github.com/LimePay/go-sdk/http"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentationName identifies the spans created by the SDK
+const InstrumentationName = "github.com/LimePay/go-sdk"
+
+// Span attribute keys
+const (
+	AttributeHTTPMethod = attribute.Key("http.request.method")
+	AttributeHTTPRoute  = attribute.Key("http.route")
+	AttributeHTTPStatus = attribute.Key("http.response.status_code")
+	AttributeErrorName  = attribute.Key("limepay.error.name")
+	AttributeErrorCode  = attribute.Key("limepay.error.code")
+)
+
+// Middleware creates a client span for every SDK call. Spans are named and
+// attributed after the route template (e.g. /payments/%s) rather than the
+// filled route, so IDs do not explode span cardinality. A nil provider
+// falls back to the one of the active span or the global one.
+func Middleware(tp trace.TracerProvider) http.Middleware {
+	return func(next http.Handler) http.Handler {
+		return func(ctx context.Context, req *http.Request) (*http.Response, error) {
+			tracer := tracer(ctx, tp)
+
+			ctx, span := tracer.Start(ctx, req.Method+" "+req.Template,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(
+					AttributeHTTPMethod.String(req.Method),
+					AttributeHTTPRoute.String(req.Template),
+				))
+			defer span.End()
+
+			resp, err := next(ctx, req)
+
+			if resp != nil {
+				span.SetAttributes(AttributeHTTPStatus.Int(resp.StatusCode))
+			}
+
+			End(span, err)
+
+			return resp, err
+		}
+	}
+}
+
+// Start starts a span for an SDK operation made of several steps, as a child
+// of the span active in ctx. A nil provider falls back to the one of the active
+// span or the global one.
+func Start(ctx context.Context, tp trace.TracerProvider, name string) (context.Context, trace.Span) {
+	return tracer(ctx, tp).Start(ctx, name)
+}
+
+// End records err on the span, if any
+func End(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+
+	apiErr := &errors.APIError{}
+	sdkErr := &errors.SDKError{}
+
+	switch {
+	case stderrors.As(err, &apiErr):
+		span.SetAttributes(AttributeErrorName.String(apiErr.ErrName), AttributeErrorCode.Int(apiErr.ErrCode))
+	case stderrors.As(err, &sdkErr):
+		span.SetAttributes(AttributeErrorName.String(sdkErr.ErrName), AttributeErrorCode.Int(sdkErr.ErrCode))
+	}
+
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+func tracer(ctx context.Context, tp trace.TracerProvider) trace.Tracer {
+	if tp == nil {
+		if span := trace.SpanFromContext(ctx); span.SpanContext().IsValid() {
+			tp = span.TracerProvider()
+		} else {
+			tp = otel.GetTracerProvider()
+		}
+	}
+
+	return tp.Tracer(InstrumentationName)
+}
+
+// Trace runs fn within a span started by Start, recording the returned error
+func Trace(ctx context.Context, tp trace.TracerProvider, name string, fn func(ctx context.Context) error) error {
+	ctx, span := Start(ctx, tp, name)
+	defer span.End()
+
+	err := fn(ctx)
+
+	End(span, err)
+
+	return err
+}
@@ -0,0 +1,134 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/LimePay/go-sdk/consts"
+	"github.com/LimePay/go-sdk/http"
+	"github.com/LimePay/go-sdk/test"
+	"github.com/LimePay/go-sdk/test/helper"
+	"github.com/LimePay/go-sdk/types"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	gock "gopkg.in/h2non/gock.v1"
+)
+
+func attributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attrs := map[attribute.Key]attribute.Value{}
+
+	for _, attr := range span.Attributes() {
+		attrs[attr.Key] = attr.Value
+	}
+
+	return attrs
+}
+
+func TestMiddleware(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Get("/payments/123").
+		Reply(200).
+		JSON(types.Payment{})
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	requester := http.NewRequester(test.Env, test.APIKey, test.APISecret, http.WithMiddleware(Middleware(tp)))
+
+	err := requester.ExecuteRequest(consts.HTTPGet, "/payments/123", nil, &types.Payment{})
+
+	spans := recorder.Ended()
+
+	et.Assert(err == nil, "Not expected error returned")
+	et.Assert(len(spans) == 1, "Expected exactly one span")
+
+	attrs := attributes(spans[0])
+
+	et.Assert(spans[0].Name() == "GET /payments/%s", "Span name does not match")
+	et.Assert(attrs[AttributeHTTPMethod].AsString() == "GET", "Span method does not match")
+	et.Assert(attrs[AttributeHTTPRoute].AsString() == consts.RouteGetPayment, "Span route template does not match")
+	et.Assert(attrs[AttributeHTTPStatus].AsInt64() == 200, "Span status does not match")
+}
+
+func TestMiddlewareWithAPIError(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	defer gock.Off()
+
+	gock.New(test.Env).
+		Post("/payments/relayed").
+		Reply(400).
+		JSON(map[string]interface{}{"errorName": "VALIDATION_ERROR", "code": 1018, "message": "Invalid"})
+
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	requester := http.NewRequester(test.Env, test.APIKey, test.APISecret, http.WithMiddleware(Middleware(tp)))
+
+	err := requester.ExecuteRequest(consts.HTTPPost, "/payments/relayed", types.Payment{}, &types.Payment{})
+
+	spans := exporter.GetSpans()
+
+	et.Assert(err != nil, "Expected error to be returned")
+	et.Assert(len(spans) == 1, "Expected exactly one span")
+	et.Assert(spans[0].Name == "POST /payments/relayed", "Span name does not match")
+	et.Assert(spans[0].Status.Code == codes.Error, "Span status should be error")
+
+	attrs := map[attribute.Key]attribute.Value{}
+
+	for _, attr := range spans[0].Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+
+	et.Assert(attrs[AttributeHTTPStatus].AsInt64() == 400, "Span status code does not match")
+	et.Assert(attrs[AttributeErrorName].AsString() == "VALIDATION_ERROR", "Span error name does not match")
+	et.Assert(attrs[AttributeErrorCode].AsInt64() == 1018, "Span error code does not match")
+}
+
+func TestTraceCreatesChildSpans(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	ctx, parent := tp.Tracer("test").Start(context.Background(), "parent")
+
+	err := Trace(ctx, nil, "child", func(ctx context.Context) error {
+		return nil
+	})
+
+	parent.End()
+
+	spans := recorder.Ended()
+
+	et.Assert(err == nil, "Not expected error returned")
+	et.Assert(len(spans) == 2, "Expected parent and child spans")
+	et.Assert(spans[0].Name() == "child", "Child span name does not match")
+	et.Assert(spans[0].Parent().SpanID() == parent.SpanContext().SpanID(), "Child span is not parented by the active span")
+}
+
+func TestTraceWithProviderWithoutParent(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	err := Trace(context.Background(), tp, "operation", func(ctx context.Context) error {
+		return Trace(ctx, nil, "step", func(ctx context.Context) error {
+			return nil
+		})
+	})
+
+	spans := recorder.Ended()
+
+	et.Assert(err == nil, "Not expected error returned")
+	et.Assert(len(spans) == 2, "Expected operation and step spans to be recorded by the provider", len(spans))
+	et.Assert(spans[1].Name() == "operation" && !spans[1].Parent().IsValid(), "Operation span should be a root span")
+	et.Assert(spans[0].Parent().SpanID() == spans[1].SpanContext().SpanID(), "Step span is not parented by the operation span")
+}
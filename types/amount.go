@@ -0,0 +1,183 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+var maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// Amount is an integer amount in the smallest unit of a currency, e.g. wei or
+// token base units. It is marshalled to JSON as a decimal string; the zero
+// value is unset and marshalled as an empty string.
+type Amount struct {
+	value *big.Int
+}
+
+// NewAmount returns an Amount holding a copy of v
+func NewAmount(v *big.Int) Amount {
+	if v == nil {
+		return Amount{}
+	}
+
+	return Amount{new(big.Int).Set(v)}
+}
+
+// ParseAmount parses a decimal integer string, such as "60000000000000000"
+func ParseAmount(value string) (Amount, error) {
+	return ParseUnits(value, 0)
+}
+
+// MustParseAmount is like ParseAmount but panics on invalid values
+func MustParseAmount(value string) Amount {
+	amount, err := ParseAmount(value)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return amount
+}
+
+// ParseUnits parses a decimal value, such as "1.5", expressed in units with the
+// given number of decimals into an amount of base units
+func ParseUnits(value string, decimals uint8) (Amount, error) {
+	whole, frac, _ := strings.Cut(value, ".")
+
+	if whole == "" || !isDigits(whole) || !isDigits(frac) {
+		return Amount{}, fmt.Errorf("amount: %q is not a non-negative decimal number", value)
+	}
+
+	frac = strings.TrimRight(frac, "0")
+
+	if len(frac) > int(decimals) {
+		return Amount{}, fmt.Errorf("amount: %q has more than %d decimals", value, decimals)
+	}
+
+	v, _ := new(big.Int).SetString(whole+frac+strings.Repeat("0", int(decimals)-len(frac)), 10)
+
+	amount := Amount{v}
+
+	return amount, amount.Validate()
+}
+
+// Ether parses a value in ether, such as "0.06", into an amount of wei
+func Ether(value string) (Amount, error) {
+	return ParseUnits(value, 18)
+}
+
+// Gwei parses a value in gwei into an amount of wei
+func Gwei(value string) (Amount, error) {
+	return ParseUnits(value, 9)
+}
+
+// Tokens parses a value in whole tokens into an amount of base units of a
+// token with the given decimals
+func Tokens(value string, decimals uint8) (Amount, error) {
+	return ParseUnits(value, decimals)
+}
+
+// IsSet reports whether the amount holds a value
+func (a Amount) IsSet() bool {
+	return a.value != nil
+}
+
+// IsZero reports whether the amount is unset or zero
+func (a Amount) IsZero() bool {
+	return a.value == nil || a.value.Sign() == 0
+}
+
+// BigInt returns a copy of the amount, or nil when it is unset
+func (a Amount) BigInt() *big.Int {
+	if a.value == nil {
+		return nil
+	}
+
+	return new(big.Int).Set(a.value)
+}
+
+// Equal reports whether both amounts are unset or hold the same value
+func (a Amount) Equal(b Amount) bool {
+	if a.value == nil || b.value == nil {
+		return a.value == b.value
+	}
+
+	return a.value.Cmp(b.value) == 0
+}
+
+// Validate checks that the amount is non-negative and fits uint256
+func (a Amount) Validate() error {
+	if a.value == nil {
+		return nil
+	}
+
+	if a.value.Sign() < 0 {
+		return fmt.Errorf("amount: %s is negative", a.value)
+	}
+
+	if a.value.Cmp(maxUint256) > 0 {
+		return fmt.Errorf("amount: %s does not fit uint256", a.value)
+	}
+
+	return nil
+}
+
+func (a Amount) String() string {
+	if a.value == nil {
+		return ""
+	}
+
+	return a.value.String()
+}
+
+// MarshalJSON -
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
+
+// UnmarshalJSON accepts a decimal string or an integer number. An empty string
+// or null leaves the amount unset.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+
+	if bytes.Equal(data, []byte("null")) {
+		*a = Amount{}
+		return nil
+	}
+
+	value := string(data)
+
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+	}
+
+	if value == "" {
+		*a = Amount{}
+		return nil
+	}
+
+	amount, err := ParseAmount(value)
+
+	if err != nil {
+		return err
+	}
+
+	*a = amount
+
+	return nil
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
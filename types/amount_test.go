@@ -0,0 +1,109 @@
+package types
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/LimePay/go-sdk/test/helper"
+)
+
+func TestParseUnits(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	tests := []struct {
+		name     string
+		parse    func() (Amount, error)
+		expected string
+	}{
+		{"wei", func() (Amount, error) { return ParseAmount("60000000000000000") }, "60000000000000000"},
+		{"zero", func() (Amount, error) { return ParseAmount("0") }, "0"},
+		{"ether", func() (Amount, error) { return Ether("0.06") }, "60000000000000000"},
+		{"whole ether", func() (Amount, error) { return Ether("1") }, "1000000000000000000"},
+		{"gwei", func() (Amount, error) { return Gwei("1.5") }, "1500000000"},
+		{"tokens", func() (Amount, error) { return Tokens("10", 18) }, "10000000000000000000"},
+		{"trailing zeros", func() (Amount, error) { return Tokens("1.2300", 2) }, "123"},
+		{"max uint256", func() (Amount, error) { return ParseAmount(maxUint256.String()) }, maxUint256.String()},
+	}
+
+	for _, tt := range tests {
+		amount, err := tt.parse()
+
+		et.Assert(err == nil, tt.name, "not expected error returned", err)
+		et.Assert(amount.String() == tt.expected, tt.name, "amount does not match:", amount.String())
+	}
+}
+
+func TestParseUnitsInvalid(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	tests := []struct {
+		name  string
+		parse func() (Amount, error)
+	}{
+		{"empty", func() (Amount, error) { return ParseAmount("") }},
+		{"exponent", func() (Amount, error) { return ParseAmount("1e18") }},
+		{"hex", func() (Amount, error) { return ParseAmount("0xde0b6b3a7640000") }},
+		{"negative", func() (Amount, error) { return ParseAmount("-1") }},
+		{"fraction of wei", func() (Amount, error) { return ParseAmount("1.5") }},
+		{"too many decimals", func() (Amount, error) { return Gwei("0.0000000001") }},
+		{"overflow", func() (Amount, error) { return ParseAmount(new(big.Int).Add(maxUint256, big.NewInt(1)).String()) }},
+	}
+
+	for _, tt := range tests {
+		_, err := tt.parse()
+
+		et.Assert(err != nil, tt.name, "expected error to be returned")
+	}
+}
+
+func TestAmountValidate(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	et.Assert(Amount{}.Validate() == nil, "Unset amount should be valid")
+	et.Assert(NewAmount(big.NewInt(-1)).Validate() != nil, "Negative amount should be invalid")
+	et.Assert(NewAmount(new(big.Int).Lsh(big.NewInt(1), 256)).Validate() != nil, "Amount exceeding uint256 should be invalid")
+	et.Assert(FundTxData{TokenAmount: NewAmount(big.NewInt(-1))}.Validate() != nil, "Fund transaction with negative amount should be invalid")
+}
+
+func TestAmountJSON(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	fundTxData := FundTxData{WeiAmount: MustParseAmount("60000000000000000")}
+
+	data, err := json.Marshal(fundTxData)
+
+	et.Assert(err == nil, "Not expected error returned")
+
+	decoded := FundTxData{}
+
+	err = json.Unmarshal(data, &decoded)
+
+	et.Assert(err == nil, "Not expected error returned")
+	et.Assert(decoded.WeiAmount.Equal(fundTxData.WeiAmount), "Wei amount does not match")
+	et.Assert(!decoded.TokenAmount.IsSet(), "Token amount should be unset")
+
+	tests := []struct {
+		name     string
+		json     string
+		expected string
+	}{
+		{"string", `"10000000000000000000"`, "10000000000000000000"},
+		{"number", `10000000000000000000`, "10000000000000000000"},
+		{"empty string", `""`, ""},
+		{"null", `null`, ""},
+	}
+
+	for _, tt := range tests {
+		amount := Amount{}
+
+		err := json.Unmarshal([]byte(tt.json), &amount)
+
+		et.Assert(err == nil, tt.name, "not expected error returned")
+		et.Assert(amount.String() == tt.expected, tt.name, "amount does not match:", amount.String())
+	}
+
+	amount := Amount{}
+
+	et.Assert(json.Unmarshal([]byte(`"1e18"`), &amount) != nil, "Expected error for exponent notation")
+}
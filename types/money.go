@@ -0,0 +1,295 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// ErrCurrencyMismatch is returned by Money arithmetic across currencies
+var ErrCurrencyMismatch = errors.New("money: currency mismatch")
+
+// ErrMoneyOverflow is returned by Money arithmetic exceeding int64 minor units
+var ErrMoneyOverflow = errors.New("money: amount out of range")
+
+// currencyExponents lists the ISO 4217 currencies without two minor unit digits
+var currencyExponents = map[string]int{
+	"BIF": 0, "CLP": 0, "DJF": 0, "GNF": 0, "ISK": 0, "JPY": 0, "KMF": 0, "KRW": 0, "PYG": 0,
+	"RWF": 0, "UGX": 0, "UYI": 0, "VND": 0, "VUV": 0, "XAF": 0, "XOF": 0, "XPF": 0,
+	"BHD": 3, "IQD": 3, "JOD": 3, "KWD": 3, "LYD": 3, "OMR": 3, "TND": 3,
+}
+
+// CurrencyExponent returns the number of minor unit digits of an ISO 4217
+// currency, defaulting to 2
+func CurrencyExponent(currency string) int {
+	if exp, ok := currencyExponents[strings.ToUpper(currency)]; ok {
+		return exp
+	}
+
+	return 2
+}
+
+// Money is an exact amount of a currency, held in its minor units (e.g. cents).
+// It is marshalled to JSON as a decimal number in major units, as LimePay API
+// expects; the currency itself is carried by the enclosing payment.
+type Money struct {
+	Minor    int64
+	Currency string
+}
+
+// NewMoney returns an amount of minor units of the currency
+func NewMoney(minor int64, currency string) Money {
+	return Money{minor, currency}
+}
+
+// ParseMoney parses a decimal amount in major units, such as "100.40". Amounts
+// with more decimals than the currency allows are rejected.
+func ParseMoney(amount string, currency string) (Money, error) {
+	exp := CurrencyExponent(currency)
+
+	sign, digits := int64(1), amount
+
+	if strings.HasPrefix(digits, "-") {
+		sign, digits = -1, digits[1:]
+	}
+
+	whole, frac, _ := strings.Cut(digits, ".")
+
+	if whole == "" || !isDigits(whole) || !isDigits(frac) {
+		return Money{}, fmt.Errorf("money: %q is not a decimal number", amount)
+	}
+
+	frac = strings.TrimRight(frac, "0")
+
+	if len(frac) > exp {
+		return Money{}, fmt.Errorf("money: %q has more than %d decimals allowed by %s", amount, exp, currency)
+	}
+
+	minor, err := strconv.ParseInt(whole+frac+strings.Repeat("0", exp-len(frac)), 10, 64)
+
+	if err != nil {
+		return Money{}, fmt.Errorf("money: %q is out of range", amount)
+	}
+
+	return Money{sign * minor, currency}, nil
+}
+
+// MustParseMoney is like ParseMoney but panics on invalid amounts
+func MustParseMoney(amount string, currency string) Money {
+	m, err := ParseMoney(amount, currency)
+
+	if err != nil {
+		panic(err)
+	}
+
+	return m
+}
+
+// MoneyFromFloat converts a float amount in major units, rounding it to the
+// nearest minor unit. It eases migrating code which used float amounts.
+func MoneyFromFloat(amount float64, currency string) Money {
+	return Money{int64(math.Round(amount * math.Pow10(CurrencyExponent(currency)))), currency}
+}
+
+// Float64 returns the amount in major units. It is meant for display only,
+// as it is subject to float rounding.
+func (m Money) Float64() float64 {
+	return float64(m.Minor) / math.Pow10(CurrencyExponent(m.Currency))
+}
+
+// Add returns m + o
+func (m Money) Add(o Money) (Money, error) {
+	if !m.sameCurrency(o) {
+		return Money{}, ErrCurrencyMismatch
+	}
+
+	sum := m.Minor + o.Minor
+
+	if (o.Minor > 0 && sum < m.Minor) || (o.Minor < 0 && sum > m.Minor) {
+		return Money{}, ErrMoneyOverflow
+	}
+
+	return Money{sum, m.Currency}, nil
+}
+
+// Sub returns m - o
+func (m Money) Sub(o Money) (Money, error) {
+	if !m.sameCurrency(o) {
+		return Money{}, ErrCurrencyMismatch
+	}
+
+	diff := m.Minor - o.Minor
+
+	if (o.Minor > 0 && diff > m.Minor) || (o.Minor < 0 && diff < m.Minor) {
+		return Money{}, ErrMoneyOverflow
+	}
+
+	return Money{diff, m.Currency}, nil
+}
+
+// Mul returns m multiplied by the given quantity
+func (m Money) Mul(quantity int64) (Money, error) {
+	product := new(big.Int).Mul(big.NewInt(m.Minor), big.NewInt(quantity))
+
+	if !product.IsInt64() {
+		return Money{}, ErrMoneyOverflow
+	}
+
+	return Money{product.Int64(), m.Currency}, nil
+}
+
+// RoundingMode defines how amounts are rounded to minor units
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds halves away from zero, as commonly done for tax
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfEven rounds halves to the even minor unit (banker's rounding)
+	RoundHalfEven
+	// RoundDown truncates towards zero
+	RoundDown
+)
+
+// MulRate returns m multiplied by rate, rounded to minor units with the given mode
+func (m Money) MulRate(rate *big.Rat, mode RoundingMode) (Money, error) {
+	product := round(new(big.Rat).Mul(new(big.Rat).SetInt64(m.Minor), rate), mode)
+
+	if !product.IsInt64() {
+		return Money{}, ErrMoneyOverflow
+	}
+
+	return Money{product.Int64(), m.Currency}, nil
+}
+
+// round rounds r to an integer with the given mode
+func round(r *big.Rat, mode RoundingMode) *big.Int {
+	quo, rem := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+
+	if rem.Sign() != 0 && mode != RoundDown {
+		// compare twice the remainder with the denominator to detect halves
+		half := new(big.Int).Abs(rem)
+		half.Lsh(half, 1)
+
+		cmp := half.Cmp(r.Denom())
+
+		if cmp > 0 || (cmp == 0 && (mode == RoundHalfUp || quo.Bit(0) == 1)) {
+			quo.Add(quo, big.NewInt(int64(r.Sign())))
+		}
+	}
+
+	return quo
+}
+
+// Cmp compares m and o, returning -1, 0 or +1
+func (m Money) Cmp(o Money) (int, error) {
+	if !m.sameCurrency(o) {
+		return 0, ErrCurrencyMismatch
+	}
+
+	switch {
+	case m.Minor < o.Minor:
+		return -1, nil
+	case m.Minor > o.Minor:
+		return 1, nil
+	}
+
+	return 0, nil
+}
+
+// IsZero reports whether the amount is zero
+func (m Money) IsZero() bool {
+	return m.Minor == 0
+}
+
+// Decimal returns the amount in major units, e.g. "100.40"
+func (m Money) Decimal() string {
+	exp := CurrencyExponent(m.Currency)
+
+	sign, minor := "", m.Minor
+
+	if minor < 0 {
+		sign = "-"
+	}
+
+	digits := strconv.FormatUint(absInt64(minor), 10)
+
+	if exp == 0 {
+		return sign + digits
+	}
+
+	if len(digits) <= exp {
+		digits = strings.Repeat("0", exp-len(digits)+1) + digits
+	}
+
+	return sign + digits[:len(digits)-exp] + "." + digits[len(digits)-exp:]
+}
+
+func (m Money) String() string {
+	return strings.TrimSpace(m.Decimal() + " " + m.Currency)
+}
+
+// MarshalJSON -
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.Decimal()), nil
+}
+
+// UnmarshalJSON reads a number in major units of the currency of m, or of a two
+// digit currency when it is not set. Unlike ParseMoney, it accepts exponent
+// notation and rounds extra decimals half to even, as amounts computed by the
+// API with floats may carry some, e.g. 24.096000000000004.
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var number json.Number
+
+	if err := json.Unmarshal(data, &number); err != nil {
+		return err
+	}
+
+	if number == "" {
+		m.Minor = 0
+		return nil
+	}
+
+	// bound the exponent, as big.Rat would otherwise expand 1e1000000000
+	if _, exp, found := strings.Cut(strings.ToLower(number.String()), "e"); found {
+		if n, err := strconv.Atoi(exp); err != nil || n > maxMoneyExponent || n < -maxMoneyExponent {
+			return fmt.Errorf("money: %q is out of range", number)
+		}
+	}
+
+	amount, ok := new(big.Rat).SetString(number.String())
+
+	if !ok {
+		return fmt.Errorf("money: %q is not a number", number)
+	}
+
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(CurrencyExponent(m.Currency))), nil)
+	minor := round(amount.Mul(amount, new(big.Rat).SetInt(scale)), RoundHalfEven)
+
+	if !minor.IsInt64() {
+		return fmt.Errorf("money: %q is out of range", number)
+	}
+
+	m.Minor = minor.Int64()
+
+	return nil
+}
+
+// maxMoneyExponent bounds the exponent of amounts read from JSON, far past
+// the range of int64 minor units
+const maxMoneyExponent = 64
+
+func (m Money) sameCurrency(o Money) bool {
+	return strings.EqualFold(m.Currency, o.Currency)
+}
+
+func absInt64(n int64) uint64 {
+	if n < 0 {
+		return uint64(-n)
+	}
+
+	return uint64(n)
+}
@@ -0,0 +1,178 @@
+package types
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/LimePay/go-sdk/test/helper"
+)
+
+func TestParseMoney(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	tests := []struct {
+		amount   string
+		currency string
+		minor    int64
+		decimal  string
+	}{
+		{"100.4", "USD", 10040, "100.40"},
+		{"16777217.01", "EUR", 1677721701, "16777217.01"},
+		{"0.05", "usd", 5, "0.05"},
+		{"-12.5", "USD", -1250, "-12.50"},
+		{"1500", "JPY", 1500, "1500"},
+		{"1500.00", "JPY", 1500, "1500"},
+		{"1.234", "BHD", 1234, "1.234"},
+		{"0", "", 0, "0.00"},
+	}
+
+	for _, tt := range tests {
+		m, err := ParseMoney(tt.amount, tt.currency)
+
+		et.Assert(err == nil, tt.amount, "not expected error returned", err)
+		et.Assert(m.Minor == tt.minor, tt.amount, "minor units do not match:", m.Minor)
+		et.Assert(m.Decimal() == tt.decimal, tt.amount, "decimal does not match:", m.Decimal())
+	}
+
+	for _, amount := range []string{"", "1.005", "1e3", "abc", "1.2.3", "99999999999999999999"} {
+		_, err := ParseMoney(amount, "USD")
+
+		et.Assert(err != nil, amount, "expected error to be returned")
+	}
+}
+
+func TestMoneyArithmetic(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	price := MustParseMoney("19.99", "USD")
+
+	tripled, err := price.Mul(3)
+
+	et.Assert(err == nil, "Not expected error returned")
+
+	total, err := tripled.Add(MustParseMoney("0.03", "USD"))
+
+	et.Assert(err == nil, "Not expected error returned")
+	et.Assert(total.String() == "60.00 USD", "Total does not match", total.String())
+
+	diff, err := total.Sub(price)
+
+	et.Assert(err == nil, "Not expected error returned")
+	et.Assert(diff.Minor == 4001, "Difference does not match", diff.Minor)
+
+	cmp, err := price.Cmp(total)
+
+	et.Assert(err == nil && cmp == -1, "Comparison does not match")
+
+	_, err = price.Add(MustParseMoney("1", "EUR"))
+
+	et.Assert(err == ErrCurrencyMismatch, "Expected currency mismatch error")
+
+	max := NewMoney(math.MaxInt64, "USD")
+
+	_, err = max.Add(NewMoney(1, "USD"))
+	et.Assert(err == ErrMoneyOverflow, "Expected addition overflow error", err)
+
+	_, err = NewMoney(math.MinInt64, "USD").Sub(NewMoney(1, "USD"))
+	et.Assert(err == ErrMoneyOverflow, "Expected subtraction overflow error", err)
+
+	_, err = max.Mul(2)
+	et.Assert(err == ErrMoneyOverflow, "Expected multiplication overflow error", err)
+
+	_, err = max.MulRate(big.NewRat(3, 2), RoundHalfUp)
+	et.Assert(err == ErrMoneyOverflow, "Expected rate multiplication overflow error", err)
+
+	et.Assert(MoneyFromFloat(100.4, "USD").Minor == 10040, "Float conversion does not match")
+	et.Assert(MoneyFromFloat(0.1+0.2, "USD").Minor == 30, "Float conversion should round to the nearest cent")
+}
+
+func TestMoneyJSON(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	item := Item{Description: "Invoice line", LineAmount: MustParseMoney("16777217.01", "USD"), Quantity: 1}
+
+	data, err := json.Marshal(item)
+
+	et.Assert(err == nil, "Not expected error returned")
+	et.Assert(string(data) == `{"_id":"","description":"Invoice line","lineAmount":16777217.01,"quantity":1}`, "Item JSON does not match", string(data))
+
+	decoded := Item{}
+
+	err = json.Unmarshal(data, &decoded)
+
+	et.Assert(err == nil, "Not expected error returned")
+	et.Assert(decoded.LineAmount.Minor == 1677721701, "Line amount does not match", decoded.LineAmount.Minor)
+}
+
+func TestPaymentUnmarshalJSONMoney(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	tests := []struct {
+		json       string
+		lineAmount int64
+		total      int64
+	}{
+		{`{"currency":"USD","items":[{"lineAmount":100.4,"quantity":1}],"paymentDetails":{"taxRate":0.2,"totalAmount":120.48}}`, 10040, 12048},
+		{`{"currency":"JPY","items":[{"lineAmount":1500,"quantity":1}],"paymentDetails":{"totalAmount":1800}}`, 1500, 1800},
+		{`{"items":[{"lineAmount":1.234,"quantity":1}],"paymentDetails":{"totalAmount":1.234},"currency":"BHD"}`, 1234, 1234},
+	}
+
+	for _, tt := range tests {
+		payment := Payment{}
+
+		err := json.Unmarshal([]byte(tt.json), &payment)
+
+		et.Assert(err == nil, tt.json, "not expected error returned", err)
+		et.Assert(payment.Items[0].LineAmount.Minor == tt.lineAmount, tt.json, "line amount does not match", payment.Items[0].LineAmount.Minor)
+		et.Assert(payment.Items[0].LineAmount.Currency == payment.Currency, tt.json, "line amount currency does not match")
+		et.Assert(payment.PaymentDetails.TotalAmount.Minor == tt.total, tt.json, "total amount does not match", payment.PaymentDetails.TotalAmount.Minor)
+		et.Assert(payment.PaymentDetails.TaxAmount.Currency == payment.Currency, tt.json, "tax amount currency does not match")
+	}
+
+	payment := Payment{}
+
+	et.Assert(json.Unmarshal([]byte(`{"currency":"USD","items":[{"lineAmount":1e30}]}`), &payment) != nil, "Expected error for out of range amount")
+	et.Assert(json.Unmarshal([]byte(`{"currency":"USD","items":[{"lineAmount":1e1000000000}]}`), &payment) != nil, "Expected error for out of range exponent")
+}
+
+func TestMoneyUnmarshalJSONRoundsAPIAmounts(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	tests := []struct {
+		json     string
+		currency string
+		minor    int64
+	}{
+		{`24.096000000000004`, "USD", 2410},
+		{`1.005`, "USD", 100},
+		{`1.015`, "USD", 102},
+		{`-1.005`, "USD", -100},
+		{`1e3`, "USD", 100000},
+		{`1.5E-1`, "USD", 15},
+		{`100.5`, "JPY", 100},
+		{`101.5`, "JPY", 102},
+		{`1.2345`, "BHD", 1234},
+	}
+
+	for _, tt := range tests {
+		m := Money{Currency: tt.currency}
+
+		err := json.Unmarshal([]byte(tt.json), &m)
+
+		et.Assert(err == nil, tt.json, "not expected error returned", err)
+		et.Assert(m.Minor == tt.minor, tt.json, tt.currency, "minor units do not match:", m.Minor)
+	}
+
+	payment := Payment{}
+
+	err := json.Unmarshal([]byte(`{"currency":"JPY","items":[{"lineAmount":100.5,"quantity":1}],"paymentDetails":{"taxAmount":24.096000000000004,"totalAmount":1e3}}`), &payment)
+
+	et.Assert(err == nil, "Not expected error returned decoding the payment", err)
+	et.Assert(payment.PaymentDetails.TotalAmount.Minor == 1000, "Total amount does not match", payment.PaymentDetails.TotalAmount.Minor)
+
+	_, err = ParseMoney("24.096000000000004", "USD")
+
+	et.Assert(err != nil, "Expected ParseMoney to stay strict")
+}
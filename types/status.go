@@ -0,0 +1,128 @@
+package types
+
+// PaymentStatus is the status of a payment. When decoding, statuses sent by
+// LimePay which are unknown to the SDK keep their raw string, so they
+// round-trip through JSON unchanged; Known maps them to PaymentStatusUnknown.
+type PaymentStatus string
+
+// Payment statuses
+const (
+	PaymentStatusNew        PaymentStatus = "NEW"
+	PaymentStatusProcessing PaymentStatus = "PROCESSING"
+	PaymentStatusSuccessful PaymentStatus = "SUCCESSFUL"
+	PaymentStatusFailed     PaymentStatus = "FAILED"
+	PaymentStatusCanceled   PaymentStatus = "CANCELED"
+	// PaymentStatusUnknown stands for any status unknown to the SDK
+	PaymentStatusUnknown PaymentStatus = "UNKNOWN"
+)
+
+// Known returns the status, or PaymentStatusUnknown when it is unknown
+func (s PaymentStatus) Known() PaymentStatus {
+	if s.IsUnknown() {
+		return PaymentStatusUnknown
+	}
+
+	return s
+}
+
+// IsUnknown reports whether the status is not one of the known statuses
+func (s PaymentStatus) IsUnknown() bool {
+	switch s {
+	case PaymentStatusNew, PaymentStatusProcessing, PaymentStatusSuccessful, PaymentStatusFailed, PaymentStatusCanceled:
+		return false
+	}
+
+	return true
+}
+
+// IsTerminal reports whether the payment cannot change status anymore
+func (s PaymentStatus) IsTerminal() bool {
+	return s == PaymentStatusSuccessful || s == PaymentStatusFailed || s == PaymentStatusCanceled
+}
+
+// IsSuccessful -
+func (s PaymentStatus) IsSuccessful() bool {
+	return s == PaymentStatusSuccessful
+}
+
+func (s PaymentStatus) String() string {
+	return string(s)
+}
+
+// PaymentType is the type of a payment. Unknown types keep their raw string
+// when decoding; Known maps them to PaymentTypeUnknown.
+type PaymentType string
+
+// Payment types
+const (
+	PaymentTypeFiat    PaymentType = "FIAT_PAYMENT"
+	PaymentTypeRelayed PaymentType = "RELAYED_PAYMENT"
+	// PaymentTypeUnknown stands for any type unknown to the SDK
+	PaymentTypeUnknown PaymentType = "UNKNOWN"
+)
+
+// Known returns the type, or PaymentTypeUnknown when it is unknown
+func (t PaymentType) Known() PaymentType {
+	if t.IsUnknown() {
+		return PaymentTypeUnknown
+	}
+
+	return t
+}
+
+// IsUnknown reports whether the type is not one of the known types
+func (t PaymentType) IsUnknown() bool {
+	return t != PaymentTypeFiat && t != PaymentTypeRelayed
+}
+
+func (t PaymentType) String() string {
+	return string(t)
+}
+
+// TxStatus is the status of a blockchain transaction of a payment. Unknown
+// statuses keep their raw string when decoding; Known maps them to
+// TxStatusUnknown.
+type TxStatus string
+
+// Transaction statuses
+const (
+	TxStatusNew        TxStatus = "NEW"
+	TxStatusProcessing TxStatus = "PROCESSING"
+	TxStatusSuccessful TxStatus = "SUCCESSFUL"
+	TxStatusFailed     TxStatus = "FAILED"
+	// TxStatusUnknown stands for any status unknown to the SDK
+	TxStatusUnknown TxStatus = "UNKNOWN"
+)
+
+// Known returns the status, or TxStatusUnknown when it is unknown
+func (s TxStatus) Known() TxStatus {
+	if s.IsUnknown() {
+		return TxStatusUnknown
+	}
+
+	return s
+}
+
+// IsUnknown reports whether the status is not one of the known statuses
+func (s TxStatus) IsUnknown() bool {
+	switch s {
+	case TxStatusNew, TxStatusProcessing, TxStatusSuccessful, TxStatusFailed:
+		return false
+	}
+
+	return true
+}
+
+// IsTerminal reports whether the transaction was mined or dropped
+func (s TxStatus) IsTerminal() bool {
+	return s == TxStatusSuccessful || s == TxStatusFailed
+}
+
+// IsSuccessful -
+func (s TxStatus) IsSuccessful() bool {
+	return s == TxStatusSuccessful
+}
+
+func (s TxStatus) String() string {
+	return string(s)
+}
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/LimePay/go-sdk/test/helper"
+)
+
+func TestPaymentStatus(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	tests := []struct {
+		status     PaymentStatus
+		unknown    bool
+		terminal   bool
+		successful bool
+	}{
+		{PaymentStatusNew, false, false, false},
+		{PaymentStatusProcessing, false, false, false},
+		{PaymentStatusSuccessful, false, true, true},
+		{PaymentStatusFailed, false, true, false},
+		{PaymentStatusCanceled, false, true, false},
+		{"REFUNDED", true, false, false},
+		{"", true, false, false},
+	}
+
+	for _, tt := range tests {
+		known := tt.status
+
+		if tt.unknown {
+			known = PaymentStatusUnknown
+		}
+
+		et.Assert(tt.status.IsUnknown() == tt.unknown, tt.status, "unknown does not match")
+		et.Assert(tt.status.Known() == known, tt.status, "known status does not match", tt.status.Known())
+		et.Assert(tt.status.IsTerminal() == tt.terminal, tt.status, "terminal does not match")
+		et.Assert(tt.status.IsSuccessful() == tt.successful, tt.status, "successful does not match")
+	}
+}
+
+func TestTxStatus(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	et.Assert(!TxStatusProcessing.IsTerminal(), "Processing transaction should not be terminal")
+	et.Assert(TxStatusFailed.IsTerminal() && !TxStatusFailed.IsSuccessful(), "Failed transaction should be terminal and unsuccessful")
+	et.Assert(TxStatusSuccessful.IsTerminal() && TxStatusSuccessful.IsSuccessful(), "Successful transaction should be terminal and successful")
+	et.Assert(TxStatus("DROPPED").IsUnknown(), "Dropped transaction status should be unknown")
+	et.Assert(TxStatus("DROPPED").Known() == TxStatusUnknown, "Dropped transaction status should map to unknown")
+	et.Assert(TxStatusFailed.Known() == TxStatusFailed, "Failed transaction status should stay known")
+	et.Assert(TxStatusUnknown.IsUnknown(), "Unknown transaction status should be unknown")
+}
+
+func TestPaymentType(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	et.Assert(!PaymentTypeFiat.IsUnknown(), "Fiat payment type should be known")
+	et.Assert(!PaymentTypeRelayed.IsUnknown(), "Relayed payment type should be known")
+	et.Assert(PaymentType("CRYPTO_PAYMENT").IsUnknown(), "Crypto payment type should be unknown")
+	et.Assert(PaymentType("CRYPTO_PAYMENT").Known() == PaymentTypeUnknown, "Crypto payment type should map to unknown")
+	et.Assert(PaymentTypeFiat.Known() == PaymentTypeFiat, "Fiat payment type should stay known")
+}
+
+func TestStatusJSONPreservesUnknownValues(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	body := `{"_id":"0","status":"REFUNDED","type":"CRYPTO_PAYMENT","currency":"USD",` +
+		`"fundTxData":{"status":"DROPPED"},"genericTransactions":[{"status":"SUCCESSFUL"}]}`
+
+	payment := Payment{}
+
+	err := json.Unmarshal([]byte(body), &payment)
+
+	et.Assert(err == nil, "Not expected error returned", err)
+	et.Assert(payment.Status == "REFUNDED" && payment.Status.Known() == PaymentStatusUnknown, "Payment status does not match", payment.Status)
+	et.Assert(payment.Type == "CRYPTO_PAYMENT" && payment.Type.IsUnknown(), "Payment type does not match", payment.Type)
+	et.Assert(payment.FundTxData.Status == "DROPPED", "Fund transaction status does not match", payment.FundTxData.Status)
+	et.Assert(payment.GenericTransactions[0].Status == TxStatusSuccessful, "Generic transaction status does not match")
+
+	data, err := json.Marshal(payment)
+
+	et.Assert(err == nil, "Not expected error returned", err)
+
+	decoded := Payment{}
+	json.Unmarshal(data, &decoded)
+
+	et.Assert(decoded.Status == payment.Status, "Payment status does not round-trip", decoded.Status)
+	et.Assert(decoded.Type == payment.Type, "Payment type does not round-trip", decoded.Type)
+	et.Assert(decoded.FundTxData.Status == payment.FundTxData.Status, "Fund transaction status does not round-trip")
+}
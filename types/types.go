@@ -1,5 +1,14 @@
 package types
 
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
 // Ping -
 type Ping struct {
 	Status string `json:"status"`
@@ -119,7 +128,7 @@ type WalletToken struct {
 // Payment -
 type Payment struct {
 	ID                  string               `json:"_id"`
-	Status              string               `json:"status"`
+	Status              PaymentStatus        `json:"status"`
 	Date                string               `json:"date"`
 	Currency            string               `json:"currency"`
 	Shopper             string               `json:"shopper"`
@@ -128,26 +137,84 @@ type Payment struct {
 	FundTxData          FundTxData           `json:"fundTxData"`
 	GenericTransactions []GenericTransaction `json:"genericTransactions"`
 	PaymentDetails      PaymentDetails       `json:"paymentDetails"`
-	Type                string               `json:"type"`
+	Type                PaymentType          `json:"type"`
 	LimeToken           string               `json:"limeToken"`
 }
 
-// Item -
+// UnmarshalJSON decodes the money amounts of the payment in its currency
+func (p *Payment) UnmarshalJSON(data []byte) error {
+	type payment Payment
+
+	head := struct {
+		Currency string `json:"currency"`
+	}{}
+
+	if err := json.Unmarshal(data, &head); err != nil {
+		return err
+	}
+
+	*p = Payment{Currency: head.Currency}
+
+	p.PaymentDetails.TaxAmount.Currency = head.Currency
+	p.PaymentDetails.BaseAmount.Currency = head.Currency
+	p.PaymentDetails.TotalAmount.Currency = head.Currency
+
+	aux := struct {
+		*payment
+		Items []json.RawMessage `json:"items"`
+	}{payment: (*payment)(p)}
+
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	if aux.Items == nil {
+		return nil
+	}
+
+	p.Items = make([]Item, len(aux.Items))
+
+	for i, raw := range aux.Items {
+		p.Items[i].LineAmount.Currency = head.Currency
+
+		if err := json.Unmarshal(raw, &p.Items[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Item is a line of a payment. LineAmount is the unit price of the item, the
+// line total being LineAmount times Quantity.
 type Item struct {
-	ID          string  `json:"_id"`
-	Description string  `json:"description"`
-	LineAmount  float32 `json:"lineAmount"`
-	Quantity    int     `json:"quantity"`
+	ID          string `json:"_id"`
+	Description string `json:"description"`
+	LineAmount  Money  `json:"lineAmount"`
+	Quantity    int    `json:"quantity"`
 }
 
 // FundTxData -
 type FundTxData struct {
-	WeiAmount              string `json:"weiAmount"`
-	TokenAmount            string `json:"tokenAmount"`
-	AuthorizationSignature string `json:"authorizationSignature"`
-	TransactionHash        string `json:"transactionHash"`
-	Status                 string `json:"status"`
-	Nonce                  string `json:"nonce"`
+	WeiAmount              Amount   `json:"weiAmount"`
+	TokenAmount            Amount   `json:"tokenAmount"`
+	AuthorizationSignature string   `json:"authorizationSignature"`
+	TransactionHash        string   `json:"transactionHash"`
+	Status                 TxStatus `json:"status"`
+	Nonce                  string   `json:"nonce"`
+}
+
+// Validate checks that the amounts of the fund transaction are valid
+func (f FundTxData) Validate() error {
+	if err := f.WeiAmount.Validate(); err != nil {
+		return fmt.Errorf("weiAmount: %w", err)
+	}
+
+	if err := f.TokenAmount.Validate(); err != nil {
+		return fmt.Errorf("tokenAmount: %w", err)
+	}
+
+	return nil
 }
 
 // GenericTransaction -
@@ -158,7 +225,7 @@ type GenericTransaction struct {
 	GasPrice          string           `json:"gasPrice"`
 	GasLimit          int              `json:"gasLimit"`
 	SignedTransaction string           `json:"signedTransaction"`
-	Status            string           `json:"status"`
+	Status            TxStatus         `json:"status"`
 	TransactionHash   string           `json:"transactionHash"`
 	FunctionParams    []FunctionParams `json:"functionParams"`
 }
@@ -172,11 +239,11 @@ type FunctionParams struct {
 
 // PaymentDetails -
 type PaymentDetails struct {
-	TaxRate     float32    `json:"taxRate"`
-	TaxAmount   float32    `json:"taxAmount"`
-	BaseAmount  float32    `json:"baseAmount"`
-	TotalAmount float32    `json:"totalAmount"`
-	CardHolder  CardHolder `json:"cardHolder"`
+	TaxRate     json.Number `json:"taxRate"`
+	TaxAmount   Money       `json:"taxAmount"`
+	BaseAmount  Money       `json:"baseAmount"`
+	TotalAmount Money       `json:"totalAmount"`
+	CardHolder  CardHolder  `json:"cardHolder"`
 }
 
 // CardHolder -
@@ -195,3 +262,135 @@ type SignatureMetadata struct {
 	ShopperAddress string `json:"shopperAddress"`
 	EscrowAddress  string `json:"escrowAddress"`
 }
+
+// ListOptions -
+type ListOptions struct {
+	Limit  int
+	Offset int
+	Cursor string
+}
+
+// Query encodes the options as query parameters
+func (o ListOptions) Query() url.Values {
+	query := url.Values{}
+
+	if o.Limit > 0 {
+		query.Set("limit", strconv.Itoa(o.Limit))
+	}
+
+	if o.Offset > 0 {
+		query.Set("offset", strconv.Itoa(o.Offset))
+	}
+
+	if o.Cursor != "" {
+		query.Set("cursor", o.Cursor)
+	}
+
+	return query
+}
+
+// Page -
+type Page[T any] struct {
+	Items      []T    `json:"data"`
+	NextCursor string `json:"nextCursor"`
+	Total      int    `json:"total"`
+}
+
+// UnmarshalJSON accepts both a page envelope and a bare array of items
+func (p *Page[T]) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		*p = Page[T]{}
+
+		return json.Unmarshal(trimmed, &p.Items)
+	}
+
+	type page Page[T]
+
+	return json.Unmarshal(trimmed, (*page)(p))
+}
+
+// SortOrder -
+type SortOrder string
+
+// Sort orders
+const (
+	SortAscending  SortOrder = "asc"
+	SortDescending SortOrder = "desc"
+)
+
+// PaymentListOptions -
+type PaymentListOptions struct {
+	ListOptions
+
+	Status   PaymentStatus
+	Type     PaymentType
+	Shopper  string
+	Vendor   string
+	Currency string
+	// From and To bound the payment date, zero values leave the range open
+	From time.Time
+	To   time.Time
+	// SortBy is the payment field to sort by, e.g. date
+	SortBy string
+	Order  SortOrder
+}
+
+// Query encodes the filters and paging options as query parameters
+func (o PaymentListOptions) Query() url.Values {
+	query := o.ListOptions.Query()
+
+	setIfNotEmpty(query, "status", string(o.Status))
+	setIfNotEmpty(query, "type", string(o.Type))
+	setIfNotEmpty(query, "shopper", o.Shopper)
+	setIfNotEmpty(query, "vendor", o.Vendor)
+	setIfNotEmpty(query, "currency", o.Currency)
+	setIfNotEmpty(query, "sortBy", o.SortBy)
+	setIfNotEmpty(query, "order", string(o.Order))
+
+	if !o.From.IsZero() {
+		query.Set("from", o.From.UTC().Format(time.RFC3339))
+	}
+
+	if !o.To.IsZero() {
+		query.Set("to", o.To.UTC().Format(time.RFC3339))
+	}
+
+	return query
+}
+
+func setIfNotEmpty(query url.Values, key string, value string) {
+	if value != "" {
+		query.Set(key, value)
+	}
+}
+
+// ShopperListOptions -
+type ShopperListOptions struct {
+	ListOptions
+
+	Email         string
+	WalletAddress string
+	Vendor        string
+	// UseLimePayWallet filters by wallet kind when set, nil matches both
+	UseLimePayWallet *bool
+	// NamePrefix matches shoppers whose first or last name starts with it
+	NamePrefix string
+}
+
+// Query encodes the filters and paging options as query parameters
+func (o ShopperListOptions) Query() url.Values {
+	query := o.ListOptions.Query()
+
+	setIfNotEmpty(query, "email", o.Email)
+	setIfNotEmpty(query, "walletAddress", o.WalletAddress)
+	setIfNotEmpty(query, "vendor", o.Vendor)
+	setIfNotEmpty(query, "namePrefix", o.NamePrefix)
+
+	if o.UseLimePayWallet != nil {
+		query.Set("useLimePayWallet", strconv.FormatBool(*o.UseLimePayWallet))
+	}
+
+	return query
+}
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/LimePay/go-sdk/test/helper"
+)
+
+func TestPaymentListOptionsQuery(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	from := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2019, 2, 1, 12, 30, 0, 0, time.FixedZone("EET", 2*60*60))
+
+	tests := []struct {
+		name     string
+		opts     PaymentListOptions
+		expected string
+	}{
+		{"empty", PaymentListOptions{}, ""},
+		{"paging", PaymentListOptions{ListOptions: ListOptions{Limit: 10, Offset: 20}}, "limit=10&offset=20"},
+		{"cursor", PaymentListOptions{ListOptions: ListOptions{Cursor: "abc"}}, "cursor=abc"},
+		{"status and type", PaymentListOptions{Status: "NEW", Type: "FIAT_PAYMENT"}, "status=NEW&type=FIAT_PAYMENT"},
+		{"shopper, vendor and currency", PaymentListOptions{Shopper: "s1", Vendor: "v1", Currency: "USD"}, "currency=USD&shopper=s1&vendor=v1"},
+		{"date range", PaymentListOptions{From: from, To: to}, "from=2019-01-01T00%3A00%3A00Z&to=2019-02-01T10%3A30%3A00Z"},
+		{"open date range", PaymentListOptions{From: from}, "from=2019-01-01T00%3A00%3A00Z"},
+		{"sort", PaymentListOptions{SortBy: "date", Order: SortDescending}, "order=desc&sortBy=date"},
+		{"everything", PaymentListOptions{ListOptions: ListOptions{Limit: 5}, Status: "PROCESSING", Order: SortAscending}, "limit=5&order=asc&status=PROCESSING"},
+	}
+
+	for _, tt := range tests {
+		actual := tt.opts.Query().Encode()
+
+		et.Assert(actual == tt.expected, tt.name, "query does not match:", actual)
+	}
+}
+
+func TestShopperListOptionsQuery(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	useLimePayWallet := true
+
+	tests := []struct {
+		name     string
+		opts     ShopperListOptions
+		expected string
+	}{
+		{"empty", ShopperListOptions{}, ""},
+		{"email", ShopperListOptions{Email: "a@b.c"}, "email=a%40b.c"},
+		{"wallet address", ShopperListOptions{WalletAddress: "0x123"}, "walletAddress=0x123"},
+		{"vendor and name prefix", ShopperListOptions{Vendor: "v1", NamePrefix: "Al"}, "namePrefix=Al&vendor=v1"},
+		{"wallet kind", ShopperListOptions{UseLimePayWallet: &useLimePayWallet}, "useLimePayWallet=true"},
+		{"paging", ShopperListOptions{ListOptions: ListOptions{Limit: 1}, Vendor: "v1"}, "limit=1&vendor=v1"},
+	}
+
+	for _, tt := range tests {
+		actual := tt.opts.Query().Encode()
+
+		et.Assert(actual == tt.expected, tt.name, "query does not match:", actual)
+	}
+}
+
+func TestPageUnmarshalJSON(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	tests := []struct {
+		name       string
+		json       string
+		items      int
+		nextCursor string
+		total      int
+	}{
+		{"bare array", `[{"_id":"1"},{"_id":"2"}]`, 2, "", 0},
+		{"envelope", `{"data":[{"_id":"1"}],"nextCursor":"abc","total":3}`, 1, "abc", 3},
+		{"empty envelope", `{"data":[]}`, 0, "", 0},
+	}
+
+	for _, tt := range tests {
+		page := &Page[Shopper]{}
+
+		err := page.UnmarshalJSON([]byte(tt.json))
+
+		et.Assert(err == nil, tt.name, "not expected error returned")
+		et.Assert(len(page.Items) == tt.items, tt.name, "items count does not match")
+		et.Assert(page.NextCursor == tt.nextCursor, tt.name, "next cursor does not match")
+		et.Assert(page.Total == tt.total, tt.name, "total does not match")
+	}
+}
@@ -0,0 +1,72 @@
+package webhooks
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/LimePay/go-sdk/payments"
+	"github.com/LimePay/go-sdk/types"
+)
+
+// EventType -
+type EventType string
+
+// Event types sent by LimePay
+const (
+	EventPaymentStatusChanged EventType = "payment.status_changed"
+	EventInvoiceSent          EventType = "invoice.sent"
+	EventShopperCreated       EventType = "shopper.created"
+)
+
+// Event is the envelope of every webhook. Data holds the raw payload of the
+// event, which is decoded into the typed events below for known types.
+type Event struct {
+	ID      string          `json:"id"`
+	Type    EventType       `json:"type"`
+	Created int64           `json:"created"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// CreatedAt returns the time the event was created at
+func (e *Event) CreatedAt() time.Time {
+	return time.Unix(e.Created, 0)
+}
+
+// PaymentStatusChangedEvent -
+type PaymentStatusChangedEvent struct {
+	Event
+	Payment        types.Payment       `json:"payment"`
+	PreviousStatus types.PaymentStatus `json:"previousStatus"`
+}
+
+// Transition returns the status change reported by the event, which can be
+// checked with Validate or passed to a payments.Tracker through Payment
+func (e *PaymentStatusChangedEvent) Transition() *payments.Transition {
+	previous := e.Payment
+	previous.Status = e.PreviousStatus
+
+	return payments.NewTransition(&previous, &e.Payment)
+}
+
+// InvoiceSentEvent -
+type InvoiceSentEvent struct {
+	Event
+	Payment types.Payment `json:"payment"`
+}
+
+// ShopperCreatedEvent -
+type ShopperCreatedEvent struct {
+	Event
+	Shopper types.Shopper `json:"shopper"`
+}
+
+// ParseEvent decodes the envelope of a webhook body
+func ParseEvent(body []byte) (*Event, error) {
+	event := &Event{}
+
+	if err := json.Unmarshal(body, event); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
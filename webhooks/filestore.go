@@ -0,0 +1,226 @@
+package webhooks
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// FileStore is a Store persisted to a single file, so deduplication survives
+// restarts. Every change is appended to the file and synced before returning;
+// the file is compacted, dropping expired and removed IDs, every time it
+// doubles in size. It is safe for concurrent use within a single process.
+type FileStore struct {
+	mu      sync.Mutex
+	path    string
+	file    *os.File
+	now     func() time.Time
+	entries map[string]time.Time
+	records int
+	// compacted is the number of records left by the last compaction
+	compacted int
+}
+
+// minCompactRecords is the number of records below which files are not compacted
+const minCompactRecords = 1024
+
+type fileRecord struct {
+	ID      string `json:"id"`
+	Expires int64  `json:"expires"`
+}
+
+// OpenFileStore opens the store at path, creating the file if needed
+func OpenFileStore(path string) (*FileStore, error) {
+	s := &FileStore{
+		path:    path,
+		now:     time.Now,
+		entries: map[string]time.Time{},
+	}
+
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+
+	if err := s.compact(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Add -
+func (s *FileStore) Add(ctx context.Context, id string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+
+	if expires, ok := s.entries[id]; ok && now.Before(expires) {
+		return false, nil
+	}
+
+	previous, existed := s.entries[id]
+	expires := now.Add(ttl)
+
+	// the entry is stored before appending, so a compaction triggered by the
+	// append writes it to the new file
+	s.entries[id] = expires
+
+	if err := s.append(fileRecord{id, expires.UnixNano()}); err != nil {
+		if existed {
+			s.entries[id] = previous
+		} else {
+			delete(s.entries, id)
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Remove -
+func (s *FileStore) Remove(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	expires, ok := s.entries[id]
+
+	if !ok {
+		return nil
+	}
+
+	delete(s.entries, id)
+
+	if err := s.append(fileRecord{ID: id}); err != nil {
+		s.entries[id] = expires
+		return err
+	}
+
+	return nil
+}
+
+// Close closes the underlying file
+func (s *FileStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.file.Close()
+}
+
+func (s *FileStore) load() error {
+	f, err := os.Open(s.path)
+
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+
+	for scanner.Scan() {
+		record := fileRecord{}
+
+		// a torn last line left by a crash is skipped
+		if json.Unmarshal(scanner.Bytes(), &record) != nil {
+			continue
+		}
+
+		if record.Expires == 0 {
+			delete(s.entries, record.ID)
+		} else {
+			s.entries[record.ID] = time.Unix(0, record.Expires)
+		}
+	}
+
+	return scanner.Err()
+}
+
+// append writes record to the file, s.entries being already updated with it.
+// The record is persisted once append returns nil; a failed compaction is
+// retried on the next append.
+func (s *FileStore) append(record fileRecord) error {
+	data, _ := json.Marshal(record)
+
+	if _, err := s.file.Write(append(data, '\n')); err != nil {
+		return err
+	}
+
+	if err := s.file.Sync(); err != nil {
+		return err
+	}
+
+	s.records++
+
+	// expired entries are only known once compacting, so the file size since
+	// the last compaction is tracked rather than the number of live entries
+	if s.records > minCompactRecords && s.records > 2*s.compacted {
+		s.compact()
+	}
+
+	return nil
+}
+
+// compact rewrites the file with the unexpired entries only, atomically
+// replacing the previous one
+func (s *FileStore) compact() error {
+	now := s.now()
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".tmp*")
+
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(tmp.Name())
+
+	w := bufio.NewWriter(tmp)
+
+	for id, expires := range s.entries {
+		if !now.Before(expires) {
+			delete(s.entries, id)
+			continue
+		}
+
+		data, _ := json.Marshal(fileRecord{id, expires.UnixNano()})
+
+		w.Write(append(data, '\n'))
+	}
+
+	if err := w.Flush(); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return err
+	}
+
+	if s.file != nil {
+		s.file.Close()
+	}
+
+	s.file, err = os.OpenFile(s.path, os.O_WRONLY|os.O_APPEND, 0600)
+	s.records = len(s.entries)
+	s.compacted = s.records
+
+	return err
+}
@@ -0,0 +1,51 @@
+package webhooks
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/LimePay/go-sdk/errors"
+)
+
+// DefaultTolerance is the maximum age of a webhook accepted by default
+const DefaultTolerance = 5 * time.Minute
+
+// Sign computes the webhook signature of body sent at timestamp: the hex encoded
+// HMAC-SHA256, keyed with the API secret, of "<unix timestamp>.<body>"
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature checks that signature matches body and that the unix
+// timestamp it was sent at is within tolerance of now
+func VerifySignature(secret string, body []byte, timestamp string, signature string, tolerance time.Duration, now time.Time) error {
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+
+	if err != nil {
+		return errors.WebhookTimestampError
+	}
+
+	sentAt := time.Unix(unix, 0)
+
+	if tolerance > 0 && (now.Sub(sentAt) > tolerance || sentAt.Sub(now) > tolerance) {
+		return errors.WebhookTimestampError
+	}
+
+	expected, _ := hex.DecodeString(Sign(secret, sentAt, body))
+	actual, err := hex.DecodeString(signature)
+
+	if err != nil || !hmac.Equal(expected, actual) {
+		return errors.InvalidWebhookSignatureError
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package webhooks
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/LimePay/go-sdk/errors"
+	"github.com/LimePay/go-sdk/test"
+	"github.com/LimePay/go-sdk/test/helper"
+)
+
+var nowMock = time.Unix(1546300800, 0)
+
+func TestSign(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	// echo -n '1546300800.{}' | openssl dgst -sha256 -hmac 'secret'
+	signature := Sign("secret", nowMock, []byte("{}"))
+
+	et.Assert(signature == "8220d05d51ee0db8fa7bf7abbf337a28acb7c34fd09e627ed5fdaaf97b1a6e77", "Signature does not match", signature)
+}
+
+func TestVerifySignature(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	body := []byte(`{"id":"evt_1"}`)
+	timestamp := strconv.FormatInt(nowMock.Unix(), 10)
+	signature := Sign(test.APISecret, nowMock, body)
+
+	tests := []struct {
+		name      string
+		secret    string
+		body      []byte
+		timestamp string
+		signature string
+		now       time.Time
+		err       error
+	}{
+		{"valid", test.APISecret, body, timestamp, signature, nowMock, nil},
+		{"within tolerance", test.APISecret, body, timestamp, signature, nowMock.Add(DefaultTolerance), nil},
+		{"wrong secret", "other", body, timestamp, signature, nowMock, errors.InvalidWebhookSignatureError},
+		{"tampered body", test.APISecret, []byte(`{"id":"evt_2"}`), timestamp, signature, nowMock, errors.InvalidWebhookSignatureError},
+		{"missing signature", test.APISecret, body, timestamp, "", nowMock, errors.InvalidWebhookSignatureError},
+		{"non hex signature", test.APISecret, body, timestamp, "signature", nowMock, errors.InvalidWebhookSignatureError},
+		{"replayed with another timestamp", test.APISecret, body, strconv.FormatInt(nowMock.Unix()+1, 10), signature, nowMock, errors.InvalidWebhookSignatureError},
+		{"missing timestamp", test.APISecret, body, "", signature, nowMock, errors.WebhookTimestampError},
+		{"stale", test.APISecret, body, timestamp, signature, nowMock.Add(DefaultTolerance + time.Second), errors.WebhookTimestampError},
+		{"from the future", test.APISecret, body, timestamp, signature, nowMock.Add(-DefaultTolerance - time.Second), errors.WebhookTimestampError},
+	}
+
+	for _, tt := range tests {
+		err := VerifySignature(tt.secret, tt.body, tt.timestamp, tt.signature, DefaultTolerance, tt.now)
+
+		et.Assert(err == tt.err, tt.name, "error does not match", err)
+	}
+}
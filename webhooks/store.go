@@ -0,0 +1,117 @@
+package webhooks
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultDeduplicationTTL is how long delivered event IDs are remembered by default
+const DefaultDeduplicationTTL = 24 * time.Hour
+
+// Store tracks the IDs of delivered events
+type Store interface {
+	// Add records id for ttl, reporting false when it is already recorded
+	Add(ctx context.Context, id string, ttl time.Duration) (bool, error)
+	// Remove forgets id, so its next delivery is processed again
+	Remove(ctx context.Context, id string) error
+}
+
+// MemoryStore is an in-memory Store evicting the least recently added IDs once
+// its capacity is reached, a zero capacity meaning no limit. Expired IDs are
+// dropped every time the number of IDs doubles. It is safe for concurrent use.
+type MemoryStore struct {
+	mu       sync.Mutex
+	capacity int
+	now      func() time.Time
+	order    *list.List
+	entries  map[string]*list.Element
+	sweepAt  int
+}
+
+// minSweepEntries is the number of IDs below which expired ones are not swept
+const minSweepEntries = 1024
+
+type memoryEntry struct {
+	id      string
+	expires time.Time
+}
+
+// NewMemoryStore creates a MemoryStore holding up to capacity IDs
+func NewMemoryStore(capacity int) *MemoryStore {
+	return &MemoryStore{
+		capacity: capacity,
+		now:      time.Now,
+		order:    list.New(),
+		entries:  map[string]*list.Element{},
+	}
+}
+
+// Add -
+func (s *MemoryStore) Add(ctx context.Context, id string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+
+	if el, ok := s.entries[id]; ok {
+		if now.Before(el.Value.(*memoryEntry).expires) {
+			return false, nil
+		}
+
+		s.remove(el)
+	}
+
+	if s.order.Len() >= s.sweepAt {
+		s.sweep(now)
+	}
+
+	for s.capacity > 0 && s.order.Len() >= s.capacity {
+		s.remove(s.order.Back())
+	}
+
+	s.entries[id] = s.order.PushFront(&memoryEntry{id, now.Add(ttl)})
+
+	return true, nil
+}
+
+// Remove -
+func (s *MemoryStore) Remove(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if el, ok := s.entries[id]; ok {
+		s.remove(el)
+	}
+
+	return nil
+}
+
+// Len returns the number of recorded IDs, including expired ones not evicted yet
+func (s *MemoryStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.order.Len()
+}
+
+// sweep drops the expired IDs
+func (s *MemoryStore) sweep(now time.Time) {
+	for el := s.order.Back(); el != nil; {
+		prev := el.Prev()
+
+		if !now.Before(el.Value.(*memoryEntry).expires) {
+			s.remove(el)
+		}
+
+		el = prev
+	}
+
+	s.sweepAt = max(2*s.order.Len(), minSweepEntries)
+}
+
+func (s *MemoryStore) remove(el *list.Element) {
+	s.order.Remove(el)
+	delete(s.entries, el.Value.(*memoryEntry).id)
+}
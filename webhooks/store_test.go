@@ -0,0 +1,208 @@
+package webhooks
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/LimePay/go-sdk/test/helper"
+)
+
+type clockMock struct {
+	now time.Time
+}
+
+func (c *clockMock) Now() time.Time {
+	return c.now
+}
+
+func TestMemoryStore(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	ctx := context.Background()
+	clock := &clockMock{nowMock}
+
+	store := NewMemoryStore(2)
+	store.now = clock.Now
+
+	added, _ := store.Add(ctx, "evt_1", time.Hour)
+	et.Assert(added, "First delivery should be added")
+
+	added, _ = store.Add(ctx, "evt_1", time.Hour)
+	et.Assert(!added, "Redelivery should be reported")
+
+	clock.now = clock.now.Add(time.Hour)
+
+	added, _ = store.Add(ctx, "evt_1", time.Hour)
+	et.Assert(added, "Expired ID should be added again")
+
+	store.Add(ctx, "evt_2", time.Hour)
+	store.Add(ctx, "evt_3", time.Hour)
+
+	et.Assert(store.Len() == 2, "Store should not exceed its capacity", store.Len())
+
+	added, _ = store.Add(ctx, "evt_1", time.Hour)
+	et.Assert(added, "Least recently added ID should be evicted")
+
+	store.Remove(ctx, "evt_3")
+
+	added, _ = store.Add(ctx, "evt_3", time.Hour)
+	et.Assert(added, "Removed ID should be added again")
+}
+
+func TestFileStore(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "webhooks.db")
+
+	store, err := OpenFileStore(path)
+
+	et.Assert(err == nil, "Not expected error returned", err)
+
+	store.now = (&clockMock{time.Now()}).Now
+
+	added, _ := store.Add(ctx, "evt_1", time.Hour)
+	et.Assert(added, "First delivery should be added")
+
+	store.Add(ctx, "evt_2", time.Hour)
+	store.Add(ctx, "evt_expired", -time.Second)
+	store.Remove(ctx, "evt_2")
+	store.Close()
+
+	// a record torn by a crash is ignored
+	f, _ := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+	f.WriteString(`{"id":"evt_3","exp`)
+	f.Close()
+
+	store, err = OpenFileStore(path)
+
+	et.Assert(err == nil, "Not expected error returned", err)
+
+	defer store.Close()
+
+	added, _ = store.Add(ctx, "evt_1", time.Hour)
+	et.Assert(!added, "Delivered ID should survive reopening")
+
+	added, _ = store.Add(ctx, "evt_2", time.Hour)
+	et.Assert(added, "Removed ID should be added again")
+
+	added, _ = store.Add(ctx, "evt_expired", time.Hour)
+	et.Assert(added, "Expired ID should be added again")
+
+	added, _ = store.Add(ctx, "evt_3", time.Hour)
+	et.Assert(added, "Torn record should be ignored")
+}
+
+func TestFileStoreCompaction(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "webhooks.db")
+
+	store, _ := OpenFileStore(path)
+	defer store.Close()
+
+	for i := 0; i < 3000; i++ {
+		id := "evt_" + strconv.Itoa(i)
+
+		store.Add(ctx, id, time.Hour)
+		store.Remove(ctx, id)
+	}
+
+	store.Add(ctx, "evt_kept", time.Hour)
+
+	info, _ := os.Stat(path)
+
+	et.Assert(info.Size() < 64*1024, "Store file should be compacted", info.Size())
+
+	added, _ := store.Add(ctx, "evt_kept", time.Hour)
+	et.Assert(!added, "Compaction should keep live IDs")
+}
+
+func TestMemoryStoreDropsExpiredIDs(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	ctx := context.Background()
+	clock := &clockMock{nowMock}
+
+	store := NewMemoryStore(0)
+	store.now = clock.Now
+
+	for i := 0; i < 5000; i++ {
+		store.Add(ctx, "evt_"+strconv.Itoa(i), time.Minute)
+	}
+
+	clock.now = clock.now.Add(time.Hour)
+
+	for i := 0; i < 5000; i++ {
+		store.Add(ctx, "evt_new_"+strconv.Itoa(i), time.Minute)
+		clock.now = clock.now.Add(time.Second)
+	}
+
+	et.Assert(store.Len() < 2*minSweepEntries, "Expired IDs should be dropped", store.Len())
+}
+
+func TestFileStoreDropsExpiredIDs(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "webhooks.db")
+	clock := &clockMock{nowMock}
+
+	store, _ := OpenFileStore(path)
+	defer store.Close()
+
+	store.now = clock.Now
+
+	for i := 0; i < 5000; i++ {
+		store.Add(ctx, "evt_"+strconv.Itoa(i), time.Minute)
+		clock.now = clock.now.Add(time.Second)
+	}
+
+	et.Assert(len(store.entries) < 2*minCompactRecords, "Expired IDs should be dropped", len(store.entries))
+	et.Assert(store.records < 2*minCompactRecords, "Store file should be compacted", store.records)
+
+	added, _ := store.Add(ctx, "evt_4999", time.Minute)
+	et.Assert(!added, "Compaction should keep live IDs")
+}
+
+func TestFileStoreCompactionKeepsLastChange(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "webhooks.db")
+
+	store, _ := OpenFileStore(path)
+
+	// the last Add crosses minCompactRecords
+	for i := 0; i <= minCompactRecords; i++ {
+		store.Add(ctx, "evt_"+strconv.Itoa(i), time.Hour)
+	}
+
+	et.Assert(store.compacted == minCompactRecords+1, "Adding should compact the file", store.compacted)
+
+	for i := minCompactRecords + 1; i < 2*(minCompactRecords+1); i++ {
+		store.Add(ctx, "evt_"+strconv.Itoa(i), time.Hour)
+	}
+
+	// the Remove doubles the file size since the last compaction
+	store.Remove(ctx, "evt_0")
+
+	et.Assert(store.compacted == 2*minCompactRecords+1, "Removing should compact the file", store.compacted)
+
+	store.Close()
+
+	store, err := OpenFileStore(path)
+	et.Assert(err == nil, "Not expected error returned", err)
+	defer store.Close()
+
+	added, _ := store.Add(ctx, "evt_"+strconv.Itoa(minCompactRecords), time.Hour)
+	et.Assert(!added, "ID added by the compacting Add should survive a restart")
+
+	added, _ = store.Add(ctx, "evt_0", time.Hour)
+	et.Assert(added, "ID removed by the compacting Remove should stay removed after a restart")
+}
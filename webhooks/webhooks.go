@@ -0,0 +1,243 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	stderrors "errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/LimePay/go-sdk/consts"
+)
+
+// MaxBodyBytes is the largest webhook body accepted by the handler
+const MaxBodyBytes = 1 << 20
+
+// Handler is an http.Handler receiving LimePay webhooks. It verifies their
+// signature, decodes them into typed events and dispatches them to the
+// registered callbacks. Callbacks are to be registered before serving.
+//
+// The handler responds 401 to unverified webhooks, 400 to malformed ones and
+// 500 when a callback fails, so that LimePay retries the delivery.
+type Handler struct {
+	secret    string
+	tolerance time.Duration
+	now       func() time.Time
+	store     Store
+	ttl       time.Duration
+
+	onDuplicate            []func(ctx context.Context, event *Event)
+	onEvent                []func(ctx context.Context, event *Event) error
+	onPaymentStatusChanged []func(ctx context.Context, event *PaymentStatusChangedEvent) error
+	onInvoiceSent          []func(ctx context.Context, event *InvoiceSentEvent) error
+	onShopperCreated       []func(ctx context.Context, event *ShopperCreatedEvent) error
+}
+
+// Option configures a Handler
+type Option func(*Handler)
+
+// WithTolerance sets the maximum age of accepted webhooks, DefaultTolerance by
+// default. A zero tolerance disables the timestamp check.
+func WithTolerance(tolerance time.Duration) Option {
+	return func(h *Handler) {
+		h.tolerance = tolerance
+	}
+}
+
+// WithClock sets the clock webhook timestamps are checked against
+func WithClock(now func() time.Time) Option {
+	return func(h *Handler) {
+		h.now = now
+	}
+}
+
+// WithDeduplication records the IDs of dispatched events in store for ttl, so
+// redelivered events are acknowledged without running the callbacks again.
+// The ttl is raised to cover the timestamp tolerance window in both directions,
+// past which replays are rejected by the signature check.
+func WithDeduplication(store Store, ttl time.Duration) Option {
+	return func(h *Handler) {
+		h.store = store
+		h.ttl = ttl
+	}
+}
+
+// NewHandler creates a handler verifying webhooks with the given API secret
+func NewHandler(secret string, opts ...Option) *Handler {
+	h := &Handler{
+		secret:    secret,
+		tolerance: DefaultTolerance,
+		now:       time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(h)
+	}
+
+	if h.store != nil && h.ttl < 2*h.tolerance {
+		h.ttl = 2 * h.tolerance
+	}
+
+	return h
+}
+
+// OnDuplicate registers a callback notified of events already dispatched,
+// which are not passed to the other callbacks
+func (h *Handler) OnDuplicate(fn func(ctx context.Context, event *Event)) {
+	h.onDuplicate = append(h.onDuplicate, fn)
+}
+
+// OnEvent registers a callback receiving every verified event, including
+// events of types unknown to the SDK
+func (h *Handler) OnEvent(fn func(ctx context.Context, event *Event) error) {
+	h.onEvent = append(h.onEvent, fn)
+}
+
+// OnPaymentStatusChanged -
+func (h *Handler) OnPaymentStatusChanged(fn func(ctx context.Context, event *PaymentStatusChangedEvent) error) {
+	h.onPaymentStatusChanged = append(h.onPaymentStatusChanged, fn)
+}
+
+// OnInvoiceSent -
+func (h *Handler) OnInvoiceSent(fn func(ctx context.Context, event *InvoiceSentEvent) error) {
+	h.onInvoiceSent = append(h.onInvoiceSent, fn)
+}
+
+// OnShopperCreated -
+func (h *Handler) OnShopperCreated(fn func(ctx context.Context, event *ShopperCreatedEvent) error) {
+	h.onShopperCreated = append(h.onShopperCreated, fn)
+}
+
+// Verify checks the signature and timestamp headers of a webhook body
+func (h *Handler) Verify(body []byte, header http.Header) error {
+	return VerifySignature(h.secret, body,
+		header.Get(consts.HeaderWebhookTimestamp), header.Get(consts.HeaderWebhookSignature), h.tolerance, h.now())
+}
+
+// ServeHTTP -
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, MaxBodyBytes))
+
+	if err != nil {
+		status := http.StatusBadRequest
+
+		if stderrors.As(err, new(*http.MaxBytesError)) {
+			status = http.StatusRequestEntityTooLarge
+		}
+
+		http.Error(w, http.StatusText(status), status)
+		return
+	}
+
+	if err := h.Verify(body, r.Header); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	event, err := ParseEvent(body)
+
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	if err := h.Dispatch(r.Context(), event); err != nil {
+		if stderrors.As(err, new(*malformedEventError)) {
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// Dispatch decodes the event and calls the callbacks registered for its type,
+// stopping at the first failing one. With deduplication enabled, callbacks run
+// at most once per event ID: the ID is recorded before dispatching and
+// forgotten if a callback fails, so the event is processed on redelivery.
+func (h *Handler) Dispatch(ctx context.Context, event *Event) error {
+	if h.store == nil || event.ID == "" {
+		return h.dispatchEvent(ctx, event)
+	}
+
+	added, err := h.store.Add(ctx, event.ID, h.ttl)
+
+	if err != nil {
+		return err
+	}
+
+	if !added {
+		for _, fn := range h.onDuplicate {
+			fn(ctx, event)
+		}
+
+		return nil
+	}
+
+	if err := h.dispatchEvent(ctx, event); err != nil {
+		h.store.Remove(ctx, event.ID)
+		return err
+	}
+
+	return nil
+}
+
+func (h *Handler) dispatchEvent(ctx context.Context, event *Event) error {
+	for _, fn := range h.onEvent {
+		if err := fn(ctx, event); err != nil {
+			return err
+		}
+	}
+
+	switch event.Type {
+	case EventPaymentStatusChanged:
+		return dispatch(ctx, event, &PaymentStatusChangedEvent{Event: *event}, h.onPaymentStatusChanged)
+	case EventInvoiceSent:
+		return dispatch(ctx, event, &InvoiceSentEvent{Event: *event}, h.onInvoiceSent)
+	case EventShopperCreated:
+		return dispatch(ctx, event, &ShopperCreatedEvent{Event: *event}, h.onShopperCreated)
+	}
+
+	return nil
+}
+
+func dispatch[T any](ctx context.Context, event *Event, typed *T, callbacks []func(ctx context.Context, event *T) error) error {
+	if len(callbacks) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(event.Data, typed); err != nil {
+		return &malformedEventError{err}
+	}
+
+	for _, fn := range callbacks {
+		if err := fn(ctx, typed); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// malformedEventError is returned by Dispatch when the data of an event cannot be decoded
+type malformedEventError struct {
+	err error
+}
+
+func (e *malformedEventError) Error() string {
+	return "webhooks: malformed event data: " + e.err.Error()
+}
+
+func (e *malformedEventError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,256 @@
+package webhooks
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/LimePay/go-sdk/consts"
+	"github.com/LimePay/go-sdk/test"
+	"github.com/LimePay/go-sdk/test/helper"
+)
+
+var paymentStatusChangedMock = `{
+	"id": "evt_1",
+	"type": "payment.status_changed",
+	"created": 1546300800,
+	"data": {
+		"previousStatus": "NEW",
+		"payment": {
+			"_id": "payment123",
+			"status": "PROCESSING",
+			"currency": "USD",
+			"shopper": "shopper123",
+			"items": [{"description": "Some description", "lineAmount": 100.4, "quantity": 1}],
+			"type": "FIAT_PAYMENT"
+		}
+	}
+}`
+
+var invoiceSentMock = `{"id":"evt_2","type":"invoice.sent","created":1546300800,"data":{"payment":{"_id":"payment123"}}}`
+
+var shopperCreatedMock = `{"id":"evt_3","type":"shopper.created","created":1546300800,"data":{"shopper":{"_id":"shopper123","email":"shopper@example.com"}}}`
+
+func newHandlerMock(opts ...Option) *Handler {
+	return NewHandler(test.APISecret, append([]Option{WithClock(func() time.Time { return nowMock })}, opts...)...)
+}
+
+func postWebhook(et *helper.ExtendedTesting, server *httptest.Server, body string, sign func(req *http.Request)) *http.Response {
+	req, _ := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(body))
+
+	sign(req)
+
+	res, err := http.DefaultClient.Do(req)
+
+	et.Assert(err == nil, "Not expected error returned", err)
+
+	return res
+}
+
+func signed(body string) func(req *http.Request) {
+	return func(req *http.Request) {
+		req.Header.Set(consts.HeaderWebhookTimestamp, strconv.FormatInt(nowMock.Unix(), 10))
+		req.Header.Set(consts.HeaderWebhookSignature, Sign(test.APISecret, nowMock, []byte(body)))
+	}
+}
+
+func TestHandlerDispatchesTypedEvents(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	handler := newHandlerMock()
+
+	var statusChanged *PaymentStatusChangedEvent
+	var invoiceSent *InvoiceSentEvent
+	var shopperCreated *ShopperCreatedEvent
+	var all []string
+
+	handler.OnEvent(func(ctx context.Context, event *Event) error {
+		all = append(all, event.ID)
+		return nil
+	})
+
+	handler.OnPaymentStatusChanged(func(ctx context.Context, event *PaymentStatusChangedEvent) error {
+		statusChanged = event
+		return nil
+	})
+
+	handler.OnInvoiceSent(func(ctx context.Context, event *InvoiceSentEvent) error {
+		invoiceSent = event
+		return nil
+	})
+
+	handler.OnShopperCreated(func(ctx context.Context, event *ShopperCreatedEvent) error {
+		shopperCreated = event
+		return nil
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	for _, body := range []string{paymentStatusChangedMock, invoiceSentMock, shopperCreatedMock} {
+		res := postWebhook(et, server, body, signed(body))
+
+		et.Assert(res.StatusCode == http.StatusOK, "Webhook should be accepted", res.StatusCode)
+	}
+
+	et.Assert(len(all) == 3, "Every event should be passed to OnEvent callbacks")
+
+	et.Assert(statusChanged != nil && statusChanged.ID == "evt_1", "Payment status changed event was not dispatched")
+	et.Assert(statusChanged.CreatedAt().Equal(nowMock), "Event creation time does not match")
+	et.Assert(statusChanged.PreviousStatus == "NEW", "Previous status does not match")
+	et.Assert(statusChanged.Payment.Status == "PROCESSING", "Payment status does not match")
+	et.Assert(statusChanged.Payment.Items[0].LineAmount.Minor == 10040, "Payment line amount does not match")
+
+	transition := statusChanged.Transition()
+
+	et.Assert(transition.From == "NEW" && transition.To == "PROCESSING", "Event transition does not match")
+	et.Assert(transition.Validate() == nil, "Event transition should be valid", transition.Validate())
+
+	et.Assert(invoiceSent != nil && invoiceSent.Payment.ID == "payment123", "Invoice sent event was not dispatched")
+
+	et.Assert(shopperCreated != nil && shopperCreated.Shopper.ID == "shopper123", "Shopper created event was not dispatched")
+	et.Assert(shopperCreated.Shopper.Email == "shopper@example.com", "Shopper email does not match")
+}
+
+func TestHandlerRejectsUnverifiedWebhooks(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	handler := newHandlerMock()
+
+	handler.OnEvent(func(ctx context.Context, event *Event) error {
+		et.Assert(false, "Unverified event should not be dispatched")
+		return nil
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	tests := []struct {
+		name string
+		sign func(req *http.Request)
+	}{
+		{"unsigned", func(req *http.Request) {}},
+		{"signed for another body", signed(invoiceSentMock)},
+		{"stale", func(req *http.Request) {
+			stale := nowMock.Add(-time.Hour)
+			req.Header.Set(consts.HeaderWebhookTimestamp, strconv.FormatInt(stale.Unix(), 10))
+			req.Header.Set(consts.HeaderWebhookSignature, Sign(test.APISecret, stale, []byte(paymentStatusChangedMock)))
+		}},
+	}
+
+	for _, tt := range tests {
+		res := postWebhook(et, server, paymentStatusChangedMock, tt.sign)
+
+		et.Assert(res.StatusCode == http.StatusUnauthorized, tt.name, "webhook should be rejected", res.StatusCode)
+	}
+}
+
+func TestHandlerResponses(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	handler := newHandlerMock()
+
+	handler.OnShopperCreated(func(ctx context.Context, event *ShopperCreatedEvent) error {
+		return stderrors.New("database unavailable")
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	malformed := `{"id":"evt_4","type":"payment.status_changed","data":{"payment":"not a payment"}}`
+	unknown := `{"id":"evt_5","type":"vendor.updated","data":{}}`
+	large := `{"id":"evt_6","data":"` + strings.Repeat("a", MaxBodyBytes) + `"}`
+
+	tests := []struct {
+		name   string
+		body   string
+		status int
+	}{
+		{"callback failure", shopperCreatedMock, http.StatusInternalServerError},
+		{"not json", "not json", http.StatusBadRequest},
+		{"malformed data", malformed, http.StatusOK},
+		{"unknown type", unknown, http.StatusOK},
+		{"too large", large, http.StatusRequestEntityTooLarge},
+	}
+
+	for _, tt := range tests {
+		res := postWebhook(et, server, tt.body, signed(tt.body))
+
+		et.Assert(res.StatusCode == tt.status, tt.name, "status does not match", res.StatusCode)
+	}
+
+	handler.OnPaymentStatusChanged(func(ctx context.Context, event *PaymentStatusChangedEvent) error {
+		return nil
+	})
+
+	res := postWebhook(et, server, malformed, signed(malformed))
+
+	et.Assert(res.StatusCode == http.StatusBadRequest, "Malformed event data should be rejected", res.StatusCode)
+
+	res, _ = http.Get(server.URL)
+
+	et.Assert(res.StatusCode == http.StatusMethodNotAllowed, "Only POST should be allowed", res.StatusCode)
+}
+
+func TestHandlerWithoutTolerance(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	handler := NewHandler(test.APISecret, WithTolerance(0))
+
+	header := http.Header{}
+	signed(invoiceSentMock)(&http.Request{Header: header})
+
+	err := handler.Verify([]byte(invoiceSentMock), header)
+
+	et.Assert(err == nil, "Timestamp check should be disabled", err)
+
+	et.Assert(handler.Verify([]byte(invoiceSentMock), http.Header{}) != nil, "Signature check should not be disabled")
+}
+
+func TestHandlerDeduplicatesEvents(t *testing.T) {
+	et := helper.WrapTesting(t)
+
+	store := NewMemoryStore(100)
+
+	handler := newHandlerMock(WithDeduplication(store, time.Minute))
+
+	et.Assert(handler.ttl == 2*DefaultTolerance, "TTL should cover the tolerance window", handler.ttl)
+
+	calls, duplicates := 0, 0
+	fail := true
+
+	handler.OnInvoiceSent(func(ctx context.Context, event *InvoiceSentEvent) error {
+		calls++
+
+		if fail {
+			fail = false
+			return stderrors.New("mail server unavailable")
+		}
+
+		return nil
+	})
+
+	handler.OnDuplicate(func(ctx context.Context, event *Event) {
+		duplicates++
+	})
+
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	statuses := []int{}
+
+	for i := 0; i < 3; i++ {
+		statuses = append(statuses, postWebhook(et, server, invoiceSentMock, signed(invoiceSentMock)).StatusCode)
+	}
+
+	et.Assert(statuses[0] == http.StatusInternalServerError, "Failed delivery should be retried", statuses[0])
+	et.Assert(statuses[1] == http.StatusOK, "Retried delivery should be processed", statuses[1])
+	et.Assert(statuses[2] == http.StatusOK, "Duplicate delivery should be acknowledged", statuses[2])
+	et.Assert(calls == 2, "Callback should run until the event is processed once", calls)
+	et.Assert(duplicates == 1, "Duplicate hook should be notified", duplicates)
+}